@@ -1,9 +1,13 @@
 package mass
 
 import (
+	"database/sql/driver"
+	"encoding/xml"
+	"flag"
 	"fmt"
 	"github.com/alancesar/gogram/measure"
 	"github.com/alancesar/gogram/numeric"
+	"math"
 )
 
 const (
@@ -12,35 +16,166 @@ const (
 	Kilogram  Unit = "kg"
 	Pound     Unit = "lb"
 	Ounce     Unit = "oz"
+	Microgram Unit = "µg"
+	Tonne     Unit = "t"
+	Grain     Unit = "gr"
+	Stone     Unit = "st"
+	ShortTon  Unit = "ton"
 
+	microgramsInGrams = 1000000
 	milligramsInGrams = 1000
 	gramsInKilograms  = 1000
+	gramsInTonnes     = 1000000
 	kilogramsInGrams  = 0.001
 	poundsInGrams     = 453.592
 	poundsInOunces    = 16
+	grainsInPounds    = 7000
+	poundsInStones    = 14
+	poundsInShortTons = 2000
+	drachmsInPounds   = 256
 )
 
 var (
-	parsers = measure.ParserMap[Mass]{
-		"mg": NewFromMilligram,
-		"g":  NewFromGram,
-		"kg": NewFromKilogram,
-		"lb": NewFromPound,
-		"oz": NewFromOunce,
+	// DefaultUnit is the unit of bare JSON numbers and of JSON objects
+	// without unit.
+	DefaultUnit = Gram
+
+	// units are the built-in units, as listed by Set.
+	units = []Unit{Microgram, Milligram, Gram, Kilogram, Tonne, Grain, Ounce, Pound, Stone, ShortTon}
+
+	mixedUnits = map[measure.System][]measure.Unit{
+		measure.Metric: {
+			{Name: string(Tonne), Factor: gramsInTonnes},
+			{Name: string(Kilogram), Factor: gramsInKilograms},
+			{Name: string(Gram), Factor: 1},
+			{Name: string(Milligram), Factor: 1.0 / milligramsInGrams},
+			{Name: string(Microgram), Factor: 1.0 / microgramsInGrams},
+		},
+		measure.Imperial: {
+			{Name: string(ShortTon), Factor: poundsInShortTons},
+			{Name: string(Pound), Factor: 1},
+			{Name: string(Ounce), Factor: 1.0 / poundsInOunces},
+			{Name: string(Grain), Factor: 1.0 / grainsInPounds},
+		},
 	}
+
+	parsers = measure.NewRegistry(measure.ParserMap[Mass]{
+		"µg":          NewFromMicrogram,
+		"μg":          NewFromMicrogram,
+		"ug":          NewFromMicrogram,
+		"mcg":         NewFromMicrogram,
+		"microgram":   NewFromMicrogram,
+		"micrograms":  NewFromMicrogram,
+		"mg":          NewFromMilligram,
+		"milligram":   NewFromMilligram,
+		"milligrams":  NewFromMilligram,
+		"g":           NewFromGram,
+		"gram":        NewFromGram,
+		"grams":       NewFromGram,
+		"kg":          NewFromKilogram,
+		"kilogram":    NewFromKilogram,
+		"kilograms":   NewFromKilogram,
+		"t":           NewFromTonne,
+		"tonne":       NewFromTonne,
+		"tonnes":      NewFromTonne,
+		"metric ton":  NewFromTonne,
+		"metric tons": NewFromTonne,
+		"gr":          NewFromGrain,
+		"grain":       NewFromGrain,
+		"grains":      NewFromGrain,
+		"oz":          NewFromOunce,
+		"ounce":       NewFromOunce,
+		"ounces":      NewFromOunce,
+		"lb":          NewFromPound,
+		"lbs":         NewFromPound,
+		"pound":       NewFromPound,
+		"pounds":      NewFromPound,
+		"st":          NewFromStone,
+		"stone":       NewFromStone,
+		"stones":      NewFromStone,
+		"ton":         NewFromShortTon,
+		"tons":        NewFromShortTon,
+		"tn":          NewFromShortTon,
+		"short ton":   NewFromShortTon,
+		"short tons":  NewFromShortTon,
+	})
 )
 
 type (
 	Unit string
 
+	// Mass may hold a negative value, meaning a difference between two
+	// masses rather than an amount of matter. Parsing and every constructor
+	// accept signed values and units are chosen by magnitude.
 	Mass struct {
 		system        measure.System
 		grams, pounds float64
 	}
+
+	NullMass = measure.Null[Mass]
+
+	// dimension registers the package with FindAll and Rewrite, which parse
+	// through Parse so compound quantities such as "1 lb 4 oz" are found whole.
+	dimension struct {
+		*measure.Registry[Mass]
+	}
 )
 
+func init() {
+	parsers.RegisterNames(names)
+	measure.RegisterDimension("mass", dimension{parsers})
+}
+
+func (d dimension) ParseMeasurable(input string) (measure.Measurable, error) {
+	return Parse(input)
+}
+
 func NewFromString(input string) Mass {
-	return parsers.Parse(input)
+	parsed, _ := Parse(input)
+	return parsed
+}
+
+// Parse parses inputs such as "1.5 kg", and compound ones such as
+// "1 lb 4 oz", summed in the system of their first term.
+func Parse(input string) (Mass, error) {
+	return measure.ParseCompound(parsers.ParseE, input)
+}
+
+// ParseLenient works like Parse, but also accepts spelled out inputs such as
+// "half a pound" or "a dozen grams". Approximate reports whether vague
+// amounts like "a couple of kilograms" were read.
+func ParseLenient(input string) (parsed Mass, approximate bool, err error) {
+	if parsed, err := Parse(input); err == nil {
+		return parsed, false, nil
+	}
+
+	return parsers.ParseLenient(input)
+}
+
+// ParseLocalized parses input written in locale, such as "1,5 kg" in German.
+func ParseLocalized(input string, locale measure.Locale) (Mass, error) {
+	return measure.ParseLocalized(Parse, input, locale)
+}
+
+// Var defines a mass flag with the specified name, default value and
+// usage string on flag.CommandLine, storing its value in p.
+func Var(p *Mass, name string, value Mass, usage string) {
+	*p = value
+	flag.Var(p, name, usage)
+}
+
+// RegisterUnit teaches the package a new unit worth factor grams, such as
+// RegisterUnit("sack", 25000, measure.Metric). The unit is accepted by
+// parsing under its name and aliases, by Float64In and StringIn, and is
+// picked by String when it fits the value better than the built-in units.
+// system must be metric or imperial.
+func RegisterUnit(name string, factor float64, system measure.System, aliases ...string) error {
+	return parsers.RegisterUnit(name, factor, system, aliases...)
+}
+
+// RegisterAlias makes alias parse the same way as unit.
+func RegisterAlias(alias string, unit Unit) error {
+	return parsers.RegisterAlias(alias, string(unit))
 }
 
 func NewFromMilligram(value float64) Mass {
@@ -63,6 +198,44 @@ func NewFromOunce(value float64) Mass {
 	return createFromImperial(value / poundsInOunces)
 }
 
+func NewFromMicrogram(value float64) Mass {
+	return createFromMetric(value / microgramsInGrams)
+}
+
+func NewFromTonne(value float64) Mass {
+	return createFromMetric(value * gramsInTonnes)
+}
+
+func NewFromGrain(value float64) Mass {
+	return createFromImperial(value / grainsInPounds)
+}
+
+func NewFromStone(value float64) Mass {
+	return createFromImperial(value * poundsInStones)
+}
+
+func NewFromShortTon(value float64) Mass {
+	return createFromImperial(value * poundsInShortTons)
+}
+
+func (m Mass) System() measure.System {
+	return m.system
+}
+
+// Base returns m in grams.
+func (m Mass) Base() float64 {
+	return m.grams
+}
+
+// FromBase returns a mass of value grams tracked in system.
+func (m Mass) FromBase(value float64, system measure.System) Mass {
+	if system == measure.Metric {
+		return createFromMetric(value)
+	}
+
+	return createFromImperial(value / poundsInGrams)
+}
+
 func (m Mass) IsZero() bool {
 	return m.grams == 0 && m.pounds == 0
 }
@@ -87,6 +260,84 @@ func (m Mass) Ounces() float64 {
 	return m.pounds * poundsInOunces
 }
 
+func (m Mass) Micrograms() float64 {
+	return m.grams * microgramsInGrams
+}
+
+func (m Mass) Tonnes() float64 {
+	return m.grams / gramsInTonnes
+}
+
+func (m Mass) Grains() float64 {
+	return m.pounds * grainsInPounds
+}
+
+func (m Mass) Stones() float64 {
+	return m.pounds / poundsInStones
+}
+
+func (m Mass) ShortTons() float64 {
+	return m.pounds / poundsInShortTons
+}
+
+// Add returns the sum of m and other. The result keeps the system m was
+// created in, so adding a metric mass to an imperial one yields an
+// imperial mass. Sub, Mul, Div, Neg and Abs follow the same rule.
+func (m Mass) Add(other Mass) Mass {
+	return m.withValue(m.valueIn(m.system) + other.valueIn(m.system))
+}
+
+func (m Mass) Sub(other Mass) Mass {
+	return m.withValue(m.valueIn(m.system) - other.valueIn(m.system))
+}
+
+func (m Mass) Mul(factor float64) Mass {
+	return m.withValue(m.valueIn(m.system) * factor)
+}
+
+func (m Mass) Div(divisor float64) Mass {
+	return m.withValue(m.valueIn(m.system) / divisor)
+}
+
+// Ratio returns how many times other fits in m, regardless of the systems
+// they were created in.
+func (m Mass) Ratio(other Mass) float64 {
+	return m.valueIn(m.system) / other.valueIn(m.system)
+}
+
+func (m Mass) Neg() Mass {
+	return m.withValue(-m.valueIn(m.system))
+}
+
+func (m Mass) Abs() Mass {
+	return m.withValue(math.Abs(m.valueIn(m.system)))
+}
+
+func (m Mass) Compare(other Mass) int {
+	return numeric.Compare(m.grams, other.grams)
+}
+
+func (m Mass) Less(other Mass) bool {
+	return m.Compare(other) < 0
+}
+
+// Equal reports whether m and other represent the same mass, even if they
+// were created in different systems.
+func (m Mass) Equal(other Mass) bool {
+	return m.Compare(other) == 0
+}
+
+// EqualWithin reports whether m and other differ by at most tolerance.
+func (m Mass) EqualWithin(other, tolerance Mass) bool {
+	return math.Abs(m.grams-other.grams) <= math.Abs(tolerance.grams)
+}
+
+// EqualWithinPercent reports whether m and other differ by at most percent
+// of the larger of them.
+func (m Mass) EqualWithinPercent(other Mass, percent float64) bool {
+	return numeric.WithinPercent(m.grams, other.grams, percent)
+}
+
 func (m Mass) String() string {
 	unit := m.findBestUnit()
 	return m.StringIn(unit)
@@ -101,6 +352,54 @@ func (m Mass) StringIn(unit Unit) string {
 	return fmt.Sprintf("%s %s", formatted, unit)
 }
 
+// StringLocalized works like String, but with the separators and unit
+// spacing of locale.
+func (m Mass) StringLocalized(locale measure.Locale) string {
+	unit := m.findBestUnit()
+	value, err := m.Float64In(unit)
+	if err != nil {
+		return ""
+	}
+	return locale.Format(value, names.Abbreviation(string(unit), locale))
+}
+
+// StringLong works like StringLocalized, but with the long name of the unit,
+// such as "2 quilogramas".
+func (m Mass) StringLong(locale measure.Locale) string {
+	unit := m.findBestUnit()
+	value, err := m.Float64In(unit)
+	if err != nil {
+		return ""
+	}
+	return names.FormatLong(value, "mass", string(unit), locale)
+}
+
+// StringInFraction works like StringIn, but formats the value as a whole
+// number and the closest fraction whose denominator is at most
+// maxDenominator, such as "1 1/2 lb".
+func (m Mass) StringInFraction(unit Unit, maxDenominator int) string {
+	value, err := m.Float64In(unit)
+	if err != nil {
+		return ""
+	}
+	formatted := numeric.FormatFraction(value, maxDenominator)
+	return fmt.Sprintf("%s %s", formatted, unit)
+}
+
+// StringMixed formats m as whole units of its system plus a remainder in
+// smallest, rounded to precision decimal places, such as "1 lb 4.5 oz". It
+// returns empty if smallest is not a unit of the system of m.
+func (m Mass) StringMixed(smallest Unit, precision int) string {
+	units := mixedUnits[m.system]
+	for i, unit := range units {
+		if unit.Name == string(smallest) {
+			return measure.FormatMixed(m.valueIn(m.system), units[:i+1], precision)
+		}
+	}
+
+	return ""
+}
+
 func (m Mass) Float64In(unit Unit) (float64, error) {
 	switch unit {
 	case Milligram:
@@ -113,7 +412,20 @@ func (m Mass) Float64In(unit Unit) (float64, error) {
 		return m.Pounds(), nil
 	case Ounce:
 		return m.Ounces(), nil
+	case Microgram:
+		return m.Micrograms(), nil
+	case Tonne:
+		return m.Tonnes(), nil
+	case Grain:
+		return m.Grains(), nil
+	case Stone:
+		return m.Stones(), nil
+	case ShortTon:
+		return m.ShortTons(), nil
 	default:
+		if custom, ok := parsers.Unit(string(unit)); ok {
+			return m.Base() / custom.Factor, nil
+		}
 		return 0, fmt.Errorf("%s is an invalid unit for mass", unit)
 	}
 }
@@ -122,30 +434,120 @@ func (m Mass) MarshalJSON() ([]byte, error) {
 	return measure.Marshal(m)
 }
 
+// MarshalJSONObject marshals m to a JSON object such as
+// {"value":1.5,"unit":"kg","system":"metric"}. measure.Object uses it.
+func (m Mass) MarshalJSONObject() ([]byte, error) {
+	unit := m.findBestUnit()
+	value, _ := m.Float64In(unit)
+	return measure.MarshalObject(value, string(unit), m.System())
+}
+
 func (m *Mass) UnmarshalJSON(bytes []byte) error {
-	return measure.Unmarshal(m, NewFromString, bytes)
+	return measure.UnmarshalWithDefault(m, Parse, string(DefaultUnit), nil, bytes)
+}
+
+func (m Mass) MarshalText() ([]byte, error) {
+	return measure.MarshalText(m)
+}
+
+func (m *Mass) UnmarshalText(text []byte) error {
+	return measure.UnmarshalText(m, Parse, text)
+}
+
+func (m Mass) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return measure.MarshalXMLAttr(m, name)
+}
+
+func (m *Mass) UnmarshalXMLAttr(attr xml.Attr) error {
+	return measure.UnmarshalXMLAttr(m, Parse, attr)
+}
+
+// Set implements flag.Value, so *Mass can be given to flag.Var.
+func (m *Mass) Set(value string) error {
+	return measure.Set(m, Parse, symbols(), value)
+}
+
+// Get implements flag.Getter.
+func (m Mass) Get() interface{} {
+	return m
+}
+
+func (m Mass) Value() (driver.Value, error) {
+	return measure.Value(m)
+}
+
+func (m *Mass) Scan(src interface{}) error {
+	return measure.Scan(m, Parse, src)
+}
+
+// symbols returns the symbols of the built-in units followed by the names
+// of the units registered at runtime.
+func symbols() []string {
+	symbols := make([]string, 0, len(units))
+	for _, unit := range units {
+		symbols = append(symbols, string(unit))
+	}
+
+	return append(symbols, parsers.Names()...)
 }
 
 func (m Mass) findBestUnit() Unit {
+	unit := m.findBuiltInUnit()
+	if value, _ := m.Float64In(unit); value != 0 {
+		if custom, ok := parsers.Best(m.system, m.Base(), math.Abs(m.Base()/value)); ok {
+			return Unit(custom.Name)
+		}
+	}
+
+	return unit
+}
+
+func (m Mass) findBuiltInUnit() Unit {
 	if m.system == measure.Metric {
+		grams := math.Abs(m.grams)
 		switch {
-		case m.grams >= gramsInKilograms:
+		case grams >= gramsInTonnes:
+			return Tonne
+		case grams >= gramsInKilograms:
 			return Kilogram
-		case m.grams < 1:
+		case grams > 0 && grams < 1.0/milligramsInGrams:
+			return Microgram
+		case grams < 1:
 			return Milligram
 		default:
 			return Gram
 		}
 	}
 
+	pounds := math.Abs(m.pounds)
 	switch {
-	case m.pounds < 1:
+	case pounds >= poundsInShortTons:
+		return ShortTon
+	case pounds > 0 && pounds < 1.0/drachmsInPounds:
+		return Grain
+	case pounds < 1:
 		return Ounce
 	default:
 		return Pound
 	}
 }
 
+func (m Mass) valueIn(system measure.System) float64 {
+	if system == measure.Metric {
+		return m.grams
+	}
+
+	return m.pounds
+}
+
+func (m Mass) withValue(value float64) Mass {
+	if m.system == measure.Metric {
+		return createFromMetric(value)
+	}
+
+	return createFromImperial(value)
+}
+
 func createFromMetric(grams float64) Mass {
 	return Mass{
 		system: measure.Metric,
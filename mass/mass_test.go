@@ -1,8 +1,13 @@
 package mass
 
 import (
+	"encoding/xml"
+	"errors"
+	"flag"
 	"github.com/alancesar/gogram/measure"
+	"github.com/alancesar/gogram/numeric"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -211,6 +216,136 @@ func TestNewFromOunce(t *testing.T) {
 	}
 }
 
+func TestNewFromMicrogram(t *testing.T) {
+	type args struct {
+		value float64
+	}
+	tests := []struct {
+		name string
+		args args
+		want Mass
+	}{
+		{
+			name: "Should parse from micrograms",
+			args: args{
+				value: 1000000,
+			},
+			want: NewFromGram(1),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewFromMicrogram(tt.args.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewFromMicrogram() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewFromTonne(t *testing.T) {
+	type args struct {
+		value float64
+	}
+	tests := []struct {
+		name string
+		args args
+		want Mass
+	}{
+		{
+			name: "Should parse from tonnes",
+			args: args{
+				value: 1,
+			},
+			want: NewFromGram(1000000),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewFromTonne(tt.args.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewFromTonne() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewFromGrain(t *testing.T) {
+	type args struct {
+		value float64
+	}
+	tests := []struct {
+		name string
+		args args
+		want Mass
+	}{
+		{
+			name: "Should parse from grains",
+			args: args{
+				value: 7000,
+			},
+			want: NewFromPound(1),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewFromGrain(tt.args.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewFromGrain() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewFromStone(t *testing.T) {
+	type args struct {
+		value float64
+	}
+	tests := []struct {
+		name string
+		args args
+		want Mass
+	}{
+		{
+			name: "Should parse from stones",
+			args: args{
+				value: 1,
+			},
+			want: NewFromPound(14),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewFromStone(tt.args.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewFromStone() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewFromShortTon(t *testing.T) {
+	type args struct {
+		value float64
+	}
+	tests := []struct {
+		name string
+		args args
+		want Mass
+	}{
+		{
+			name: "Should parse from short tons",
+			args: args{
+				value: 1,
+			},
+			want: NewFromPound(2000),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewFromShortTon(tt.args.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewFromShortTon() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestNewFromString(t *testing.T) {
 	type args struct {
 		input string
@@ -255,6 +390,90 @@ func TestNewFromString(t *testing.T) {
 			},
 			want: NewFromOunce(1),
 		},
+		{
+			name: "Should parse from '-1kg' string",
+			args: args{
+				input: "-1kg",
+			},
+			want: NewFromKilogram(-1),
+		},
+		{
+			name: "Should parse from '250µg' string",
+			args: args{
+				input: "250µg",
+			},
+			want: NewFromMicrogram(250),
+		},
+		{
+			name: "Should parse from '250 mcg' string",
+			args: args{
+				input: "250 mcg",
+			},
+			want: NewFromMicrogram(250),
+		},
+		{
+			name: "Should parse from '2 t' string",
+			args: args{
+				input: "2 t",
+			},
+			want: NewFromTonne(2),
+		},
+		{
+			name: "Should parse from '2 Tonnes' string",
+			args: args{
+				input: "2 Tonnes",
+			},
+			want: NewFromTonne(2),
+		},
+		{
+			name: "Should parse from '15 gr' string",
+			args: args{
+				input: "15 gr",
+			},
+			want: NewFromGrain(15),
+		},
+		{
+			name: "Should parse from '15 grains' string",
+			args: args{
+				input: "15 grains",
+			},
+			want: NewFromGrain(15),
+		},
+		{
+			name: "Should parse from '11 st' string",
+			args: args{
+				input: "11 st",
+			},
+			want: NewFromStone(11),
+		},
+		{
+			name: "Should parse from '3 short tons' string",
+			args: args{
+				input: "3 short tons",
+			},
+			want: NewFromShortTon(3),
+		},
+		{
+			name: "Should parse from '3 tons' string",
+			args: args{
+				input: "3 tons",
+			},
+			want: NewFromShortTon(3),
+		},
+		{
+			name: "Should parse from '5 lbs' string",
+			args: args{
+				input: "5 lbs",
+			},
+			want: NewFromPound(5),
+		},
+		{
+			name: "Should parse from '5 kilograms' string",
+			args: args{
+				input: "5 kilograms",
+			},
+			want: NewFromKilogram(5),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -265,6 +484,111 @@ func TestNewFromString(t *testing.T) {
 	}
 }
 
+func TestParse(t *testing.T) {
+	type args struct {
+		input string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Mass
+		wantErr error
+	}{
+		{
+			name: "Should parse from '1kg' string",
+			args: args{
+				input: "1kg",
+			},
+			want:    NewFromKilogram(1),
+			wantErr: nil,
+		},
+		{
+			name: "Should return error for empty input",
+			args: args{
+				input: "",
+			},
+			want:    Mass{},
+			wantErr: measure.ErrEmptyInput,
+		},
+		{
+			name: "Should return error for malformed number",
+			args: args{
+				input: "xkg",
+			},
+			want:    Mass{},
+			wantErr: measure.ErrMalformedNumber,
+		},
+		{
+			name: "Should return error for unknown unit",
+			args: args{
+				input: "1 xx",
+			},
+			want:    Mass{},
+			wantErr: measure.ErrUnknownUnit,
+		},
+		{
+			name: "Should parse from compound '1 lb 4 oz' string",
+			args: args{
+				input: "1 lb 4 oz",
+			},
+			want:    NewFromPound(1.25),
+			wantErr: nil,
+		},
+		{
+			name: "Should parse from compound '-1 lb 8 oz' string",
+			args: args{
+				input: "-1 lb 8 oz",
+			},
+			want:    NewFromPound(-1.5),
+			wantErr: nil,
+		},
+		{
+			name: "Should parse from compound '2 st 3 lb 8oz' string",
+			args: args{
+				input: "2 st 3 lb 8oz",
+			},
+			want:    NewFromPound(31.5),
+			wantErr: nil,
+		},
+		{
+			name: "Should return error for unknown unit in compound '1 lb 4 xx' string",
+			args: args{
+				input: "1 lb 4 xx",
+			},
+			want:    Mass{},
+			wantErr: measure.ErrUnknownUnit,
+		},
+		{
+			name: "Should parse from fractional '1 ½ lb' string",
+			args: args{
+				input: "1 ½ lb",
+			},
+			want:    NewFromPound(1.5),
+			wantErr: nil,
+		},
+		{
+			name: "Should parse from fractional '1 1/2 lb 4 oz' string",
+			args: args{
+				input: "1 1/2 lb 4 oz",
+			},
+			want:    NewFromPound(1.75),
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.args.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestMass_Milligrams(t *testing.T) {
 	type fields struct {
 		grams float64
@@ -400,324 +724,1648 @@ func TestMass_Ounces(t *testing.T) {
 	}
 }
 
-func TestMass_IsZero(t *testing.T) {
-	type fields struct {
-		grams float64
-	}
+func TestMass_Micrograms(t *testing.T) {
 	tests := []struct {
-		name   string
-		fields fields
-		want   bool
+		name string
+		mass Mass
+		want float64
 	}{
 		{
-			name: "Should return true if is empty",
-			fields: fields{
-				grams: 0,
-			},
-			want: true,
+			name: "Should return micrograms properly",
+			mass: NewFromGram(0.5),
+			want: 500000,
 		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.mass.Micrograms(); got != tt.want {
+				t.Errorf("Micrograms() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMass_Tonnes(t *testing.T) {
+	tests := []struct {
+		name string
+		mass Mass
+		want float64
+	}{
 		{
-			name: "Should return false if is not empty",
-			fields: fields{
-				grams: 1,
-			},
-			want: false,
+			name: "Should return tonnes properly",
+			mass: NewFromGram(2500000),
+			want: 2.5,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			m := NewFromGram(tt.fields.grams)
-			if got := m.IsZero(); got != tt.want {
-				t.Errorf("IsZero() = %v, want %v", got, tt.want)
+			if got := tt.mass.Tonnes(); got != tt.want {
+				t.Errorf("Tonnes() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-func TestMass_String(t *testing.T) {
-	type fields struct {
-		system measure.System
-		grams  float64
-		pounds float64
-	}
+func TestMass_Grains(t *testing.T) {
 	tests := []struct {
-		name          string
-		fields        fields
-		wantFormatted string
+		name string
+		mass Mass
+		want float64
 	}{
 		{
-			name: "Should print 1 g",
-			fields: fields{
-				system: measure.Metric,
+			name: "Should return grains properly",
+			mass: NewFromPound(0.5),
+			want: 3500,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.mass.Grains(); got != tt.want {
+				t.Errorf("Grains() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMass_Stones(t *testing.T) {
+	tests := []struct {
+		name string
+		mass Mass
+		want float64
+	}{
+		{
+			name: "Should return stones properly",
+			mass: NewFromPound(21),
+			want: 1.5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.mass.Stones(); got != tt.want {
+				t.Errorf("Stones() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMass_ShortTons(t *testing.T) {
+	tests := []struct {
+		name string
+		mass Mass
+		want float64
+	}{
+		{
+			name: "Should return short tons properly",
+			mass: NewFromPound(3000),
+			want: 1.5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.mass.ShortTons(); got != tt.want {
+				t.Errorf("ShortTons() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMass_System(t *testing.T) {
+	tests := []struct {
+		name string
+		mass Mass
+		want measure.System
+	}{
+		{
+			name: "Should return metric",
+			mass: NewFromGram(1),
+			want: measure.Metric,
+		},
+		{
+			name: "Should return imperial",
+			mass: NewFromPound(1),
+			want: measure.Imperial,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.mass.System(); got != tt.want {
+				t.Errorf("System() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMass_Base(t *testing.T) {
+	tests := []struct {
+		name string
+		mass Mass
+		want float64
+	}{
+		{
+			name: "Should return grams",
+			mass: NewFromKilogram(2),
+			want: 2000,
+		},
+		{
+			name: "Should return grams from pounds",
+			mass: NewFromPound(1),
+			want: 453.592,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.mass.Base(); got != tt.want {
+				t.Errorf("Base() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMass_FromBase(t *testing.T) {
+	type args struct {
+		value  float64
+		system measure.System
+	}
+	tests := []struct {
+		name string
+		args args
+		want Mass
+	}{
+		{
+			name: "Should create metric mass",
+			args: args{
+				value:  1000,
+				system: measure.Metric,
+			},
+			want: NewFromKilogram(1),
+		},
+		{
+			name: "Should create imperial mass",
+			args: args{
+				value:  907.184,
+				system: measure.Imperial,
+			},
+			want: NewFromPound(2),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := (Mass{}).FromBase(tt.args.value, tt.args.system); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FromBase() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMass_IsZero(t *testing.T) {
+	type fields struct {
+		grams float64
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   bool
+	}{
+		{
+			name: "Should return true if is empty",
+			fields: fields{
+				grams: 0,
+			},
+			want: true,
+		},
+		{
+			name: "Should return false if is not empty",
+			fields: fields{
+				grams: 1,
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewFromGram(tt.fields.grams)
+			if got := m.IsZero(); got != tt.want {
+				t.Errorf("IsZero() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMass_Add(t *testing.T) {
+	type args struct {
+		other Mass
+	}
+	tests := []struct {
+		name string
+		mass Mass
+		args args
+		want Mass
+	}{
+		{
+			name: "Should add grams",
+			mass: NewFromGram(500),
+			args: args{
+				other: NewFromKilogram(1.5),
+			},
+			want: NewFromGram(2000),
+		},
+		{
+			name: "Should keep the imperial system",
+			mass: NewFromPound(1),
+			args: args{
+				other: NewFromGram(453.592),
+			},
+			want: NewFromPound(2),
+		},
+		{
+			name: "Should keep the metric system",
+			mass: NewFromGram(453.592),
+			args: args{
+				other: NewFromPound(1),
+			},
+			want: NewFromGram(907.184),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.mass.Add(tt.args.other); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Add() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMass_Sub(t *testing.T) {
+	type args struct {
+		other Mass
+	}
+	tests := []struct {
+		name string
+		mass Mass
+		args args
+		want Mass
+	}{
+		{
+			name: "Should subtract ounces",
+			mass: NewFromPound(1),
+			args: args{
+				other: NewFromOunce(4),
+			},
+			want: NewFromPound(0.75),
+		},
+		{
+			name: "Should return a negative mass",
+			mass: NewFromGram(100),
+			args: args{
+				other: NewFromGram(250),
+			},
+			want: NewFromGram(-150),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.mass.Sub(tt.args.other); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Sub() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMass_Mul(t *testing.T) {
+	type args struct {
+		factor float64
+	}
+	tests := []struct {
+		name string
+		mass Mass
+		args args
+		want Mass
+	}{
+		{
+			name: "Should scale grams",
+			mass: NewFromGram(250),
+			args: args{
+				factor: 4,
+			},
+			want: NewFromGram(1000),
+		},
+		{
+			name: "Should scale pounds",
+			mass: NewFromPound(2),
+			args: args{
+				factor: 1.5,
+			},
+			want: NewFromPound(3),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.mass.Mul(tt.args.factor); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Mul() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMass_Div(t *testing.T) {
+	type args struct {
+		divisor float64
+	}
+	tests := []struct {
+		name string
+		mass Mass
+		args args
+		want Mass
+	}{
+		{
+			name: "Should divide grams",
+			mass: NewFromKilogram(1),
+			args: args{
+				divisor: 4,
+			},
+			want: NewFromGram(250),
+		},
+		{
+			name: "Should divide pounds",
+			mass: NewFromPound(3),
+			args: args{
+				divisor: 2,
+			},
+			want: NewFromPound(1.5),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.mass.Div(tt.args.divisor); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Div() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMass_Ratio(t *testing.T) {
+	type args struct {
+		other Mass
+	}
+	tests := []struct {
+		name string
+		mass Mass
+		args args
+		want float64
+	}{
+		{
+			name: "Should get the ratio between grams",
+			mass: NewFromGram(1000),
+			args: args{
+				other: NewFromGram(250),
+			},
+			want: 4,
+		},
+		{
+			name: "Should get the ratio between systems",
+			mass: NewFromPound(2),
+			args: args{
+				other: NewFromGram(453.592),
+			},
+			want: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.mass.Ratio(tt.args.other); got != tt.want {
+				t.Errorf("Ratio() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMass_Neg(t *testing.T) {
+	tests := []struct {
+		name string
+		mass Mass
+		want Mass
+	}{
+		{
+			name: "Should negate grams",
+			mass: NewFromGram(10),
+			want: NewFromGram(-10),
+		},
+		{
+			name: "Should negate pounds",
+			mass: NewFromPound(-1),
+			want: NewFromPound(1),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.mass.Neg(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Neg() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMass_Abs(t *testing.T) {
+	tests := []struct {
+		name string
+		mass Mass
+		want Mass
+	}{
+		{
+			name: "Should return the absolute grams",
+			mass: NewFromGram(-10),
+			want: NewFromGram(10),
+		},
+		{
+			name: "Should keep positive pounds",
+			mass: NewFromPound(1),
+			want: NewFromPound(1),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.mass.Abs(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Abs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMass_Compare(t *testing.T) {
+	type args struct {
+		other Mass
+	}
+	tests := []struct {
+		name string
+		mass Mass
+		args args
+		want int
+	}{
+		{
+			name: "Should return 0 for one pound and its grams",
+			mass: NewFromPound(1),
+			args: args{
+				other: NewFromGram(453.592),
+			},
+			want: 0,
+		},
+		{
+			name: "Should return -1 if less",
+			mass: NewFromOunce(1),
+			args: args{
+				other: NewFromGram(100),
+			},
+			want: -1,
+		},
+		{
+			name: "Should return 1 if greater",
+			mass: NewFromKilogram(1),
+			args: args{
+				other: NewFromPound(2),
+			},
+			want: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.mass.Compare(tt.args.other); got != tt.want {
+				t.Errorf("Compare() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMass_EqualWithin(t *testing.T) {
+	type args struct {
+		other     Mass
+		tolerance Mass
+	}
+	tests := []struct {
+		name string
+		mass Mass
+		args args
+		want bool
+	}{
+		{
+			name: "Should return true if within tolerance",
+			mass: NewFromPound(1),
+			args: args{
+				other:     NewFromGram(450),
+				tolerance: NewFromGram(5),
+			},
+			want: true,
+		},
+		{
+			name: "Should return false if out of tolerance",
+			mass: NewFromPound(1),
+			args: args{
+				other:     NewFromGram(440),
+				tolerance: NewFromGram(5),
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.mass.EqualWithin(tt.args.other, tt.args.tolerance); got != tt.want {
+				t.Errorf("EqualWithin() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMass_String(t *testing.T) {
+	type fields struct {
+		system measure.System
+		grams  float64
+		pounds float64
+	}
+	tests := []struct {
+		name          string
+		fields        fields
+		wantFormatted string
+	}{
+		{
+			name: "Should print 1 g",
+			fields: fields{
+				system: measure.Metric,
 				grams:  1,
 			},
-			wantFormatted: "1 g",
+			wantFormatted: "1 g",
+		},
+		{
+			name: "Should print 1 kg",
+			fields: fields{
+				system: measure.Metric,
+				grams:  1000,
+			},
+			wantFormatted: "1 kg",
+		},
+		{
+			name: "Should print 1 mg",
+			fields: fields{
+				system: measure.Metric,
+				grams:  0.001,
+			},
+			wantFormatted: "1 mg",
+		},
+		{
+			name: "Should print zero in mg",
+			fields: fields{
+				system: measure.Metric,
+			},
+			wantFormatted: "0 mg",
+		},
+		{
+			name: "Should print zero in oz",
+			fields: fields{
+				system: measure.Imperial,
+			},
+			wantFormatted: "0 oz",
+		},
+		{
+			name: "Should print 1 oz",
+			fields: fields{
+				system: measure.Imperial,
+				pounds: 0.0625,
+			},
+			wantFormatted: "1 oz",
+		},
+		{
+			name: "Should print 1 lb",
+			fields: fields{
+				system: measure.Imperial,
+				pounds: 1,
+			},
+			wantFormatted: "1 lb",
+		},
+		{
+			name: "Should print -1 kg",
+			fields: fields{
+				system: measure.Metric,
+				grams:  -1000,
+			},
+			wantFormatted: "-1 kg",
+		},
+		{
+			name: "Should print -1 oz",
+			fields: fields{
+				system: measure.Imperial,
+				pounds: -0.0625,
+			},
+			wantFormatted: "-1 oz",
+		},
+		{
+			name: "Should print 500 µg",
+			fields: fields{
+				system: measure.Metric,
+				grams:  0.0005,
+			},
+			wantFormatted: "500 µg",
+		},
+		{
+			name: "Should print 2.5 t",
+			fields: fields{
+				system: measure.Metric,
+				grams:  2500000,
+			},
+			wantFormatted: "2.5 t",
+		},
+		{
+			name: "Should print 14 gr",
+			fields: fields{
+				system: measure.Imperial,
+				pounds: 0.002,
+			},
+			wantFormatted: "14 gr",
+		},
+		{
+			name: "Should print 1.5 ton",
+			fields: fields{
+				system: measure.Imperial,
+				pounds: 3000,
+			},
+			wantFormatted: "1.5 ton",
+		},
+		{
+			name: "Should print 1999 lb",
+			fields: fields{
+				system: measure.Imperial,
+				pounds: 1999,
+			},
+			wantFormatted: "1999 lb",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := Mass{
+				system: tt.fields.system,
+				grams:  tt.fields.grams,
+				pounds: tt.fields.pounds,
+			}
+			if gotFormatted := m.String(); gotFormatted != tt.wantFormatted {
+				t.Errorf("String() = %v, want %v", gotFormatted, tt.wantFormatted)
+			}
+		})
+	}
+}
+
+func TestMass_MarshalJSON(t *testing.T) {
+	type fields struct {
+		system measure.System
+		grams  float64
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name: "Should marshall properly",
+			fields: fields{
+				system: measure.Metric,
+				grams:  100,
+			},
+			want:    []byte(`"100 g"`),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := Mass{
+				system: tt.fields.system,
+				grams:  tt.fields.grams,
+			}
+			got, err := m.MarshalJSON()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalJSON() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMass_UnmarshalJSON(t *testing.T) {
+	type args struct {
+		bytes []byte
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Mass
+		wantErr bool
+	}{
+		{
+			name: "Should unmarshall properly",
+			args: args{
+				bytes: []byte(`"100 g"`),
+			},
+			want:    NewFromGram(100),
+			wantErr: false,
+		},
+		{
+			name: "Should unmarshal the object form",
+			args: args{
+				bytes: []byte(`{"value":1.5,"unit":"lb","system":"imperial"}`),
+			},
+			want:    NewFromPound(1.5),
+			wantErr: false,
+		},
+		{
+			name: "Should unmarshal bare numbers in the default unit",
+			args: args{
+				bytes: []byte("250"),
+			},
+			want:    NewFromGram(250),
+			wantErr: false,
+		},
+		{
+			name: "Should return error for unknown unit",
+			args: args{
+				bytes: []byte(`"100 xx"`),
+			},
+			want:    Mass{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &Mass{}
+			if err := m.UnmarshalJSON(tt.args.bytes); (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !reflect.DeepEqual(*m, tt.want) {
+				t.Errorf("MarshalJSON() got = %v, want %v", m, tt.want)
+			}
+		})
+	}
+}
+
+func TestMass_MarshalJSONObject(t *testing.T) {
+	tests := []struct {
+		name     string
+		quantity Mass
+		want     []byte
+		wantErr  bool
+	}{
+		{
+			name:     "Should marshal NewFromKilogram(1.5) as object",
+			quantity: NewFromKilogram(1.5),
+			want:     []byte(`{"value":1.5,"unit":"kg","system":"metric"}`),
+			wantErr:  false,
+		},
+		{
+			name:     "Should marshal NewFromOunce(4) as object",
+			quantity: NewFromOunce(4),
+			want:     []byte(`{"value":4,"unit":"oz","system":"imperial"}`),
+			wantErr:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.quantity.MarshalJSONObject()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarshalJSONObject() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalJSONObject() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMass_StringIn(t *testing.T) {
+	type fields struct {
+		grams float64
+	}
+	type args struct {
+		unit Unit
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		want   string
+	}{
+		{
+			name: "Should print 1000 g",
+			fields: fields{
+				grams: 1000,
+			},
+			args: args{
+				unit: Gram,
+			},
+			want: "1000 g",
+		},
+		{
+			name: "Should print 2.2 lbs",
+			fields: fields{
+				grams: 453.592,
+			},
+			args: args{
+				unit: Pound,
+			},
+			want: "1 lb",
+		},
+		{
+			name: "Should print empty string if receive an invalid unit",
+			fields: fields{
+				grams: 1000,
+			},
+			args: args{
+				unit: "Invalid",
+			},
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewFromGram(tt.fields.grams)
+			if got := m.StringIn(tt.args.unit); got != tt.want {
+				t.Errorf("StringIn() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMass_Float64In(t *testing.T) {
+	type fields struct {
+		grams float64
+	}
+	type args struct {
+		unit Unit
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    float64
+		wantErr bool
+	}{
+		{
+			name: "Should get 1 g",
+			fields: fields{
+				grams: 1,
+			},
+			args: args{
+				unit: Gram,
+			},
+			want:    1,
+			wantErr: false,
+		},
+		{
+			name: "Should get 1 kg",
+			fields: fields{
+				grams: 1000,
+			},
+			args: args{
+				unit: Kilogram,
+			},
+			want:    1,
+			wantErr: false,
+		},
+		{
+			name: "Should get 1 mg",
+			fields: fields{
+				grams: 0.001,
+			},
+			args: args{
+				unit: Milligram,
+			},
+			want:    1,
+			wantErr: false,
+		},
+		{
+			name: "Should get 1 lb",
+			fields: fields{
+				grams: 453.592,
+			},
+			args: args{
+				unit: Pound,
+			},
+			want:    1,
+			wantErr: false,
+		},
+		{
+			name: "Should get 1 oz",
+			fields: fields{
+				grams: 28.3495,
+			},
+			args: args{
+				unit: Ounce,
+			},
+			want:    1,
+			wantErr: false,
+		},
+		{
+			name: "Should return error for invalid unit",
+			fields: fields{
+				grams: 1000,
+			},
+			args: args{
+				unit: "Invalid",
+			},
+			want:    0,
+			wantErr: true,
+		},
+		{
+			name: "Should get 1 t",
+			fields: fields{
+				grams: 1000000,
+			},
+			args: args{
+				unit: Tonne,
+			},
+			want:    1,
+			wantErr: false,
+		},
+		{
+			name: "Should get 1 st",
+			fields: fields{
+				grams: 6350.288,
+			},
+			args: args{
+				unit: Stone,
+			},
+			want:    1,
+			wantErr: false,
+		},
+		{
+			name: "Should get 7000 gr",
+			fields: fields{
+				grams: 453.592,
+			},
+			args: args{
+				unit: Grain,
+			},
+			want:    7000,
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewFromGram(tt.fields.grams)
+			got, err := m.Float64In(tt.args.unit)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Float64In() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Float64In() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMass_aggregates(t *testing.T) {
+	values := []Mass{NewFromPound(1), NewFromOunce(8), NewFromGram(453.592)}
+	tests := []struct {
+		name string
+		got  Mass
+		want Mass
+	}{
+		{
+			name: "Should sum in pounds",
+			got:  measure.Sum(values...),
+			want: NewFromPound(2.5),
+		},
+		{
+			name: "Should average in pounds",
+			got:  measure.Mean(values...),
+			want: NewFromPound(2.5 / 3),
+		},
+		{
+			name: "Should get the median in pounds",
+			got:  measure.Median(values...),
+			want: NewFromPound(1),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.got.Equal(tt.want) || tt.got.System() != tt.want.System() {
+				t.Errorf("got = %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMass_Scan(t *testing.T) {
+	want := NewFromOunce(4)
+	value, err := want.Value()
+	if err != nil {
+		t.Fatalf("Value() error = %v", err)
+	}
+	var got Mass
+	if err := got.Scan(value); err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Scan() got = %v, want %v", got, want)
+	}
+}
+
+func TestNullMass(t *testing.T) {
+	var got NullMass
+	if err := got.UnmarshalJSON([]byte(`"1.5 kg"`)); err != nil {
+		t.Fatalf("UnmarshalJSON() error = %v", err)
+	}
+	if want := measure.NewNull(NewFromKilogram(1.5)); !reflect.DeepEqual(got, want) {
+		t.Errorf("UnmarshalJSON() got = %v, want %v", got, want)
+	}
+	if bytes, _ := got.MarshalJSON(); string(bytes) != `"1.5 kg"` {
+		t.Errorf("MarshalJSON() got = %s, want %s", bytes, `"1.5 kg"`)
+	}
+}
+
+func TestMass_xml(t *testing.T) {
+	type document struct {
+		XMLName   xml.Name `xml:"document"`
+		Attribute Mass     `xml:"weight,attr"`
+		Element   Mass     `xml:"weight"`
+	}
+	tests := []struct {
+		name string
+		doc  document
+		want string
+	}{
+		{
+			name: "Should encode and decode attributes and elements",
+			doc: document{
+				Attribute: NewFromKilogram(1.5),
+				Element:   NewFromKilogram(1.5),
+			},
+			want: `<document weight="1.5 kg"><weight>1.5 kg</weight></document>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded, err := xml.Marshal(tt.doc)
+			if err != nil {
+				t.Fatalf("xml.Marshal() error = %v", err)
+			}
+			if string(encoded) != tt.want {
+				t.Errorf("xml.Marshal() got = %s, want %s", encoded, tt.want)
+			}
+
+			var decoded document
+			if err := xml.Unmarshal(encoded, &decoded); err != nil {
+				t.Fatalf("xml.Unmarshal() error = %v", err)
+			}
+			if !reflect.DeepEqual(decoded.Attribute, tt.doc.Attribute) || !reflect.DeepEqual(decoded.Element, tt.doc.Element) {
+				t.Errorf("xml.Unmarshal() got = %v, want %v", decoded, tt.doc)
+			}
+		})
+	}
+}
+
+func TestVar(t *testing.T) {
+	defer func(commandLine *flag.FlagSet) {
+		flag.CommandLine = commandLine
+	}(flag.CommandLine)
+	flag.CommandLine = flag.NewFlagSet("test", flag.ContinueOnError)
+
+	var got Mass
+	Var(&got, "value", NewFromGram(500), "usage")
+	if err := flag.Set("value", "23kg"); err != nil {
+		t.Errorf("flag.Set() error = %v", err)
+	}
+	if want := NewFromKilogram(23); !reflect.DeepEqual(flag.Lookup("value").Value.(flag.Getter).Get(), want) {
+		t.Errorf("Var() got = %v, want %v", got, want)
+	}
+
+	wantMessage := "accepted units: µg, mg, g, kg, t, gr, oz, lb, st, ton"
+	if err := flag.Set("value", "5 xx"); err == nil || !strings.HasSuffix(err.Error(), wantMessage) {
+		t.Errorf("flag.Set() error = %v, want message %v", err, wantMessage)
+	}
+}
+
+func TestRegisterUnit(t *testing.T) {
+	defer func(original *measure.Registry[Mass]) {
+		parsers = original
+	}(parsers)
+	parsers = measure.NewRegistry(measure.ParserMap[Mass]{"g": NewFromGram, "kg": NewFromKilogram})
+
+	if err := RegisterUnit("sack", 25000, measure.Metric, "sacks"); err != nil {
+		t.Fatalf("RegisterUnit() error = %v", err)
+	}
+
+	got, err := Parse("3 sacks")
+	if err != nil {
+		t.Errorf("Parse() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, NewFromKilogram(75)) {
+		t.Errorf("Parse() got = %v, want %v", got, NewFromKilogram(75))
+	}
+	if got.String() != "3 sack" {
+		t.Errorf("String() got = %v, want %v", got.String(), "3 sack")
+	}
+	if got := NewFromKilogram(10).String(); got != "10 kg" {
+		t.Errorf("String() got = %v, want %v", got, "10 kg")
+	}
+	if got, err := NewFromKilogram(60).Float64In("sack"); err != nil || numeric.Compare(got, 2.4) != 0 {
+		t.Errorf("Float64In() got = %v, %v, want %v", got, err, 2.4)
+	}
+	if err := RegisterUnit("sack", 25000, measure.Metric, "sacks"); !errors.Is(err, measure.ErrUnitAlreadyRegistered) {
+		t.Errorf("RegisterUnit() error = %v, want %v", err, measure.ErrUnitAlreadyRegistered)
+	}
+	if err := RegisterUnit("bag", 10, measure.USCustomary); !errors.Is(err, measure.ErrInvalidUnit) {
+		t.Errorf("RegisterUnit() error = %v, want %v", err, measure.ErrInvalidUnit)
+	}
+}
+
+func TestRegisterAlias(t *testing.T) {
+	defer func(original *measure.Registry[Mass]) {
+		parsers = original
+	}(parsers)
+	parsers = measure.NewRegistry(measure.ParserMap[Mass]{"g": NewFromGram, "kg": NewFromKilogram})
+
+	if err := RegisterAlias("kilo", Kilogram); err != nil {
+		t.Fatalf("RegisterAlias() error = %v", err)
+	}
+
+	got, err := Parse("2 kilo")
+	if err != nil {
+		t.Errorf("Parse() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, NewFromKilogram(2)) {
+		t.Errorf("Parse() got = %v, want %v", got, NewFromKilogram(2))
+	}
+}
+
+func TestMass_StringMixed(t *testing.T) {
+	type args struct {
+		smallest  Unit
+		precision int
+	}
+	tests := []struct {
+		name     string
+		quantity Mass
+		args     args
+		want     string
+	}{
+		{
+			name:     "Should format pounds and ounces",
+			quantity: NewFromPound(1.28125),
+			args: args{
+				smallest:  Ounce,
+				precision: 1,
+			},
+			want: "1 lb 4.5 oz",
+		},
+		{
+			name:     "Should format down to grains",
+			quantity: NewFromOunce(4.01),
+			args: args{
+				smallest:  Grain,
+				precision: 0,
+			},
+			want: "4 oz 4 gr",
+		},
+		{
+			name:     "Should format kilograms and grams",
+			quantity: NewFromGram(1250),
+			args: args{
+				smallest:  Gram,
+				precision: 0,
+			},
+			want: "1 kg 250 g",
 		},
 		{
-			name: "Should print 1 kg",
-			fields: fields{
-				system: measure.Metric,
-				grams:  1000,
+			name:     "Should stop at the smallest unit",
+			quantity: NewFromGram(1250.4),
+			args: args{
+				smallest:  Kilogram,
+				precision: 2,
 			},
-			wantFormatted: "1 kg",
+			want: "1.25 kg",
 		},
 		{
-			name: "Should print 1 mg",
-			fields: fields{
-				system: measure.Metric,
-				grams:  0.001,
+			name:     "Should return empty for units of other systems",
+			quantity: NewFromPound(1),
+			args: args{
+				smallest:  Gram,
+				precision: 0,
 			},
-			wantFormatted: "1 mg",
+			want: "",
 		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.quantity.StringMixed(tt.args.smallest, tt.args.precision); got != tt.want {
+				t.Errorf("StringMixed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMass_StringInFraction(t *testing.T) {
+	type args struct {
+		unit           Unit
+		maxDenominator int
+	}
+	tests := []struct {
+		name     string
+		quantity Mass
+		args     args
+		want     string
+	}{
 		{
-			name: "Should print 1 oz",
-			fields: fields{
-				system: measure.Imperial,
-				pounds: 0.0625,
+			name:     "Should format pounds as fraction",
+			quantity: NewFromPound(1.5),
+			args: args{
+				unit:           Pound,
+				maxDenominator: 8,
 			},
-			wantFormatted: "1 oz",
+			want: "1 1/2 lb",
 		},
 		{
-			name: "Should print 1 lb",
-			fields: fields{
-				system: measure.Imperial,
-				pounds: 1,
+			name:     "Should approximate ounces",
+			quantity: NewFromOunce(2.3),
+			args: args{
+				unit:           Ounce,
+				maxDenominator: 4,
 			},
-			wantFormatted: "1 lb",
+			want: "2 1/3 oz",
+		},
+		{
+			name:     "Should return empty for invalid units",
+			quantity: NewFromPound(1),
+			args: args{
+				unit:           Unit("xx"),
+				maxDenominator: 8,
+			},
+			want: "",
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			m := Mass{
-				system: tt.fields.system,
-				grams:  tt.fields.grams,
-				pounds: tt.fields.pounds,
-			}
-			if gotFormatted := m.String(); gotFormatted != tt.wantFormatted {
-				t.Errorf("String() = %v, want %v", gotFormatted, tt.wantFormatted)
+			if got := tt.quantity.StringInFraction(tt.args.unit, tt.args.maxDenominator); got != tt.want {
+				t.Errorf("StringInFraction() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-func TestMass_MarshalJSON(t *testing.T) {
-	type fields struct {
-		system measure.System
-		grams  float64
+func TestParseLenient(t *testing.T) {
+	type args struct {
+		input string
 	}
 	tests := []struct {
-		name    string
-		fields  fields
-		want    []byte
-		wantErr bool
+		name            string
+		args            args
+		want            Mass
+		wantApproximate bool
+		wantErr         bool
 	}{
 		{
-			name: "Should marshall properly",
-			fields: fields{
-				system: measure.Metric,
-				grams:  100,
+			name: "Should parse compound inputs like Parse",
+			args: args{
+				input: "1 lb 4 oz",
 			},
-			want:    []byte(`"100 g"`),
-			wantErr: false,
+			want:            NewFromPound(1.25),
+			wantApproximate: false,
+			wantErr:         false,
+		},
+		{
+			name: "Should parse 'half a pound'",
+			args: args{
+				input: "half a pound",
+			},
+			want:            NewFromPound(0.5),
+			wantApproximate: false,
+			wantErr:         false,
+		},
+		{
+			name: "Should parse 'a dozen grams'",
+			args: args{
+				input: "a dozen grams",
+			},
+			want:            NewFromGram(12),
+			wantApproximate: false,
+			wantErr:         false,
+		},
+		{
+			name: "Should parse 'a couple of kilograms'",
+			args: args{
+				input: "a couple of kilograms",
+			},
+			want:            NewFromKilogram(2),
+			wantApproximate: true,
+			wantErr:         false,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			m := Mass{
-				system: tt.fields.system,
-				grams:  tt.fields.grams,
-			}
-			got, err := m.MarshalJSON()
+			got, approximate, err := ParseLenient(tt.args.input)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("MarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("ParseLenient() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("MarshalJSON() got = %v, want %v", got, tt.want)
+				t.Errorf("ParseLenient() got = %v, want %v", got, tt.want)
+			}
+			if approximate != tt.wantApproximate {
+				t.Errorf("ParseLenient() approximate = %v, want %v", approximate, tt.wantApproximate)
 			}
 		})
 	}
 }
 
-func TestMass_UnmarshalJSON(t *testing.T) {
-	type args struct {
-		bytes []byte
-	}
+func TestFindAll(t *testing.T) {
 	tests := []struct {
-		name    string
-		args    args
-		want    Mass
-		wantErr bool
+		name string
+		text string
+		want []measure.Match
 	}{
 		{
-			name: "Should unmarshall properly",
-			args: args{
-				bytes: []byte(`"100 g"`),
+			name: "Should find every mass in the text, joining compound ones",
+			text: "Add 5 kg malt and 1 lb 4 oz hops",
+			want: []measure.Match{
+				{
+					Dimension: "mass",
+					Value:     NewFromKilogram(5),
+					Text:      "5 kg",
+					Start:     4,
+					End:       8,
+				},
+				{
+					Dimension: "mass",
+					Value:     NewFromPound(1.25),
+					Text:      "1 lb 4 oz",
+					Start:     18,
+					End:       27,
+				},
 			},
-			want:    NewFromGram(100),
-			wantErr: false,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			m := &Mass{}
-			if err := m.UnmarshalJSON(tt.args.bytes); (err != nil) != tt.wantErr {
-				t.Errorf("UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
-			}
-
-			if !reflect.DeepEqual(*m, tt.want) {
-				t.Errorf("MarshalJSON() got = %v, want %v", m, tt.want)
+			if got := measure.FindAll(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FindAll() = %+v, want %+v", got, tt.want)
 			}
 		})
 	}
 }
 
-func TestMass_StringIn(t *testing.T) {
-	type fields struct {
-		grams float64
-	}
+func TestRewrite(t *testing.T) {
 	type args struct {
-		unit Unit
+		text    string
+		system  measure.System
+		options measure.RewriteOptions
 	}
 	tests := []struct {
-		name   string
-		fields fields
-		args   args
-		want   string
+		name string
+		args args
+		want string
 	}{
 		{
-			name: "Should print 1000 g",
-			fields: fields{
-				grams: 1000,
+			name: "Should convert imperial masses to metric",
+			args: args{
+				text:    "Add 1 lb 4 oz of hops and 5 kg malt.",
+				system:  measure.Metric,
+				options: measure.RewriteOptions{Precision: 0, KeepOriginal: false},
 			},
+			want: "Add 567 g of hops and 5 kg malt.",
+		},
+		{
+			name: "Should keep the original in parentheses",
 			args: args{
-				unit: Gram,
+				text:    "Add 1 lb 4 oz of hops and 5 kg malt.",
+				system:  measure.Metric,
+				options: measure.RewriteOptions{Precision: 0, KeepOriginal: true},
 			},
-			want: "1000 g",
+			want: "Add 567 g (1 lb 4 oz) of hops and 5 kg malt.",
 		},
 		{
-			name: "Should print 2.2 lbs",
-			fields: fields{
-				grams: 453.592,
+			name: "Should convert metric masses to imperial",
+			args: args{
+				text:    "Add 500 g of sugar.",
+				system:  measure.Imperial,
+				options: measure.RewriteOptions{Precision: 2, KeepOriginal: false},
 			},
+			want: "Add 1.1 lb of sugar.",
+		},
+		{
+			name: "Should convert numbers with thousands separators",
 			args: args{
-				unit: Pound,
+				text:    "Add 1,000 g of sugar.",
+				system:  measure.Imperial,
+				options: measure.RewriteOptions{Precision: 2, KeepOriginal: false},
 			},
-			want: "1 lb",
+			want: "Add 2.2 lb of sugar.",
 		},
 		{
-			name: "Should print empty string if receive an invalid unit",
-			fields: fields{
-				grams: 1000,
+			name: "Should keep three significant digits with zero options",
+			args: args{
+				text:    "Add 10 grams of salt.",
+				system:  measure.Imperial,
+				options: measure.RewriteOptions{},
 			},
+			want: "Add 0.353 oz of salt.",
+		},
+		{
+			name: "Should keep single-letter units as is",
 			args: args{
-				unit: "Invalid",
+				text:    "Serves 4 t of sugar.",
+				system:  measure.Imperial,
+				options: measure.RewriteOptions{Precision: 2, KeepOriginal: false},
 			},
-			want: "",
+			want: "Serves 4 t of sugar.",
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			m := NewFromGram(tt.fields.grams)
-			if got := m.StringIn(tt.args.unit); got != tt.want {
-				t.Errorf("StringIn() = %v, want %v", got, tt.want)
+			if got := measure.Rewrite(tt.args.text, tt.args.system, tt.args.options); got != tt.want {
+				t.Errorf("Rewrite() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-func TestMass_Float64In(t *testing.T) {
-	type fields struct {
-		grams float64
-	}
+func TestParseLocalized(t *testing.T) {
 	type args struct {
-		unit Unit
+		input  string
+		locale measure.Locale
 	}
 	tests := []struct {
 		name    string
-		fields  fields
 		args    args
-		want    float64
+		want    Mass
 		wantErr bool
 	}{
 		{
-			name: "Should get 1 g",
-			fields: fields{
-				grams: 1,
-			},
+			name: "Should parse German decimals",
 			args: args{
-				unit: Gram,
+				input:  "1,5 kg",
+				locale: measure.German,
 			},
-			want:    1,
+			want:    NewFromKilogram(1.5),
 			wantErr: false,
 		},
 		{
-			name: "Should get 1 kg",
-			fields: fields{
-				grams: 1000,
+			name: "Should parse Brazilian grouped numbers",
+			args: args{
+				input:  "1.234,5 g",
+				locale: measure.BrazilianPortuguese,
 			},
+			want:    NewFromGram(1234.5),
+			wantErr: false,
+		},
+		{
+			name: "Should parse English compound quantities",
 			args: args{
-				unit: Kilogram,
+				input:  "1 lb 4 oz",
+				locale: measure.English,
 			},
-			want:    1,
+			want:    NewFromPound(1.25),
 			wantErr: false,
 		},
 		{
-			name: "Should get 1 mg",
-			fields: fields{
-				grams: 0.001,
+			name: "Should return error for unknown units",
+			args: args{
+				input:  "1,5 foo",
+				locale: measure.German,
+			},
+			want:    Mass{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseLocalized(tt.args.input, tt.args.locale)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseLocalized() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseLocalized() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMass_StringLocalized(t *testing.T) {
+	type args struct {
+		locale measure.Locale
+	}
+	tests := []struct {
+		name string
+		m    Mass
+		args args
+		want string
+	}{
+		{
+			name: "Should format in English",
+			m:    NewFromKilogram(1.5),
+			args: args{
+				locale: measure.English,
 			},
+			want: "1.5 kg",
+		},
+		{
+			name: "Should format in Brazilian Portuguese",
+			m:    NewFromGram(999.5),
 			args: args{
-				unit: Milligram,
+				locale: measure.BrazilianPortuguese,
 			},
-			want:    1,
-			wantErr: false,
+			want: "999,5 g",
 		},
 		{
-			name: "Should get 1 lb",
-			fields: fields{
-				grams: 453.592,
+			name: "Should format in French",
+			m:    NewFromKilogram(1.25),
+			args: args{
+				locale: measure.French,
 			},
+			want: "1,25\u00a0kg",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.m.StringLocalized(tt.args.locale); got != tt.want {
+				t.Errorf("StringLocalized() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMass_StringLong(t *testing.T) {
+	type args struct {
+		locale measure.Locale
+	}
+	tests := []struct {
+		name string
+		m    Mass
+		args args
+		want string
+	}{
+		{
+			name: "Should use the English plural",
+			m:    NewFromKilogram(2),
 			args: args{
-				unit: Pound,
+				locale: measure.English,
 			},
-			want:    1,
-			wantErr: false,
+			want: "2 kilograms",
 		},
 		{
-			name: "Should get 1 oz",
-			fields: fields{
-				grams: 28.3495,
+			name: "Should use the English singular",
+			m:    NewFromPound(1),
+			args: args{
+				locale: measure.English,
 			},
+			want: "1 pound",
+		},
+		{
+			name: "Should use the Brazilian plural",
+			m:    NewFromKilogram(2),
 			args: args{
-				unit: Ounce,
+				locale: measure.BrazilianPortuguese,
 			},
-			want:    1,
-			wantErr: false,
+			want: "2 quilogramas",
 		},
 		{
-			name: "Should return error for invalid unit",
-			fields: fields{
-				grams: 1000,
+			name: "Should use the Brazilian singular below two",
+			m:    NewFromKilogram(1.5),
+			args: args{
+				locale: measure.BrazilianPortuguese,
 			},
+			want: "1,5 quilograma",
+		},
+		{
+			name: "Should use German names",
+			m:    NewFromOunce(3),
 			args: args{
-				unit: "Invalid",
+				locale: measure.German,
 			},
-			want:    0,
-			wantErr: true,
+			want: "3\u00a0Unzen",
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			m := NewFromGram(tt.fields.grams)
-			got, err := m.Float64In(tt.args.unit)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("Float64In() error = %v, wantErr %v", err, tt.wantErr)
-				return
-			}
-			if got != tt.want {
-				t.Errorf("Float64In() got = %v, want %v", got, tt.want)
+			if got := tt.m.StringLong(tt.args.locale); got != tt.want {
+				t.Errorf("StringLong() = %q, want %q", got, tt.want)
 			}
 		})
 	}
 }
+
+func Test_names(t *testing.T) {
+	for unit, byLocale := range names {
+		want := NewFromString("2 " + unit)
+		for tag, name := range byLocale {
+			for _, alias := range []string{name.Singular, name.Plural, name.Abbreviation} {
+				if alias == "" {
+					continue
+				}
+				if got := NewFromString("2 " + alias); !reflect.DeepEqual(got, want) {
+					t.Errorf("%s name %q of %q parsed as %v, want %v", tag, alias, unit, got, want)
+				}
+			}
+		}
+	}
+}
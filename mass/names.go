@@ -0,0 +1,66 @@
+package mass
+
+import "github.com/alancesar/gogram/measure"
+
+var names = measure.NameCatalog{
+	string(Microgram): {
+		"en":    {Singular: "microgram", Plural: "micrograms"},
+		"pt-BR": {Singular: "micrograma", Plural: "microgramas"},
+		"de":    {Singular: "Mikrogramm", Plural: "Mikrogramm"},
+		"fr":    {Singular: "microgramme", Plural: "microgrammes"},
+	},
+	string(Milligram): {
+		"en":    {Singular: "milligram", Plural: "milligrams"},
+		"pt-BR": {Singular: "miligrama", Plural: "miligramas"},
+		"de":    {Singular: "Milligramm", Plural: "Milligramm"},
+		"fr":    {Singular: "milligramme", Plural: "milligrammes"},
+	},
+	string(Gram): {
+		"en":    {Singular: "gram", Plural: "grams"},
+		"pt-BR": {Singular: "grama", Plural: "gramas"},
+		"de":    {Singular: "Gramm", Plural: "Gramm"},
+		"fr":    {Singular: "gramme", Plural: "grammes"},
+	},
+	string(Kilogram): {
+		"en":    {Singular: "kilogram", Plural: "kilograms"},
+		"pt-BR": {Singular: "quilograma", Plural: "quilogramas"},
+		"de":    {Singular: "Kilogramm", Plural: "Kilogramm"},
+		"fr":    {Singular: "kilogramme", Plural: "kilogrammes"},
+	},
+	string(Tonne): {
+		"en":    {Singular: "tonne", Plural: "tonnes"},
+		"pt-BR": {Singular: "tonelada", Plural: "toneladas"},
+		"de":    {Singular: "Tonne", Plural: "Tonnen"},
+		"fr":    {Singular: "tonne", Plural: "tonnes"},
+	},
+	string(Grain): {
+		"en":    {Singular: "grain", Plural: "grains"},
+		"pt-BR": {Singular: "grão", Plural: "grãos"},
+		"de":    {Singular: "Grain", Plural: "Grains"},
+		"fr":    {Singular: "grain", Plural: "grains"},
+	},
+	string(Ounce): {
+		"en":    {Singular: "ounce", Plural: "ounces"},
+		"pt-BR": {Singular: "onça", Plural: "onças"},
+		"de":    {Singular: "Unze", Plural: "Unzen"},
+		"fr":    {Singular: "once", Plural: "onces"},
+	},
+	string(Pound): {
+		"en":    {Singular: "pound", Plural: "pounds"},
+		"pt-BR": {Singular: "libra", Plural: "libras"},
+		"de":    {Singular: "Pfund", Plural: "Pfund"},
+		"fr":    {Singular: "livre", Plural: "livres"},
+	},
+	string(Stone): {
+		"en":    {Singular: "stone", Plural: "stones"},
+		"pt-BR": {Singular: "stone", Plural: "stones"},
+		"de":    {Singular: "Stone", Plural: "Stones"},
+		"fr":    {Singular: "stone", Plural: "stones"},
+	},
+	string(ShortTon): {
+		"en":    {Singular: "short ton", Plural: "short tons"},
+		"pt-BR": {Singular: "tonelada curta", Plural: "toneladas curtas"},
+		"de":    {Singular: "amerikanische Tonne", Plural: "amerikanische Tonnen"},
+		"fr":    {Singular: "tonne courte", Plural: "tonnes courtes"},
+	},
+}
@@ -0,0 +1,134 @@
+package measure
+
+import "math"
+
+type (
+	// Quantity is a Measurable that can be converted to and from a plain
+	// value in the base unit of its dimension, such as grams or liters.
+	Quantity[T any] interface {
+		Comparable[T]
+		System() System
+		Base() float64
+		FromBase(value float64, system System) T
+	}
+
+	// Additive is a Quantity whose values can be meaningfully summed.
+	// Absolute temperatures, for instance, are not additive.
+	Additive[T any] interface {
+		Quantity[T]
+		Add(other T) T
+	}
+)
+
+// Sum returns the total of values in the system most of them were created
+// in, or the zero value if there are none.
+func Sum[T Additive[T]](values ...T) T {
+	var empty T
+	if len(values) == 0 {
+		return empty
+	}
+
+	total := 0.0
+	for _, value := range values {
+		total += value.Base()
+	}
+
+	return empty.FromBase(total, majoritySystem(values))
+}
+
+// Mean returns the arithmetic mean of values in the system most of them
+// were created in, or the zero value if there are none.
+func Mean[T Quantity[T]](values ...T) T {
+	var empty T
+	if len(values) == 0 {
+		return empty
+	}
+
+	return empty.FromBase(mean(values), majoritySystem(values))
+}
+
+// Median returns the middle of values, or the mean of the two middle ones
+// when there is an even number of them, in the system most of them were
+// created in. It returns the zero value if there are none.
+func Median[T Quantity[T]](values ...T) T {
+	var empty T
+	if len(values) == 0 {
+		return empty
+	}
+
+	sorted := make([]T, len(values))
+	copy(sorted, values)
+	Sort(sorted)
+
+	middle := len(sorted) / 2
+	median := sorted[middle].Base()
+	if len(sorted)%2 == 0 {
+		median = (sorted[middle-1].Base() + median) / 2
+	}
+
+	return empty.FromBase(median, majoritySystem(values))
+}
+
+// StdDev returns the population standard deviation of values in the system
+// most of them were created in, or the zero value if there are none. Absolute
+// temperatures are not Additive; temperature.StdDev returns their deviation
+// as a temperature.Delta instead.
+func StdDev[T Additive[T]](values ...T) T {
+	var empty T
+	if len(values) == 0 {
+		return empty
+	}
+
+	deviation, system := Deviation(values...)
+	return empty.FromBase(deviation, system)
+}
+
+// Deviation returns the population standard deviation of values in base
+// units and the system most of them were created in. It returns zero and
+// Metric if there are none.
+func Deviation[T Quantity[T]](values ...T) (float64, System) {
+	if len(values) == 0 {
+		return 0, Metric
+	}
+
+	average := mean(values)
+	variance := 0.0
+	for _, value := range values {
+		variance += math.Pow(value.Base()-average, 2)
+	}
+	variance /= float64(len(values))
+
+	return math.Sqrt(variance), majoritySystem(values)
+}
+
+func mean[T Quantity[T]](values []T) float64 {
+	total := 0.0
+	for _, value := range values {
+		total += value.Base()
+	}
+
+	return total / float64(len(values))
+}
+
+// majoritySystem returns the most frequent system among values, preferring
+// the one that appears first on ties.
+func majoritySystem[T Quantity[T]](values []T) System {
+	counts := make(map[System]int)
+	var order []System
+	for _, value := range values {
+		system := value.System()
+		if counts[system] == 0 {
+			order = append(order, system)
+		}
+		counts[system]++
+	}
+
+	majority := order[0]
+	for _, system := range order[1:] {
+		if counts[system] > counts[majority] {
+			majority = system
+		}
+	}
+
+	return majority
+}
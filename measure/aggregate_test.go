@@ -0,0 +1,198 @@
+package measure
+
+import (
+	"reflect"
+	"testing"
+)
+
+type (
+	fakeQuantity struct {
+		system System
+		base   float64
+	}
+)
+
+func (f fakeQuantity) IsZero() bool {
+	return f.base == 0
+}
+
+func (f fakeQuantity) Compare(other fakeQuantity) int {
+	return fakeComparable(f.base).Compare(fakeComparable(other.base))
+}
+
+func (f fakeQuantity) System() System {
+	return f.system
+}
+
+func (f fakeQuantity) Base() float64 {
+	return f.base
+}
+
+func (f fakeQuantity) FromBase(value float64, system System) fakeQuantity {
+	return fakeQuantity{
+		system: system,
+		base:   value,
+	}
+}
+
+func (f fakeQuantity) Add(other fakeQuantity) fakeQuantity {
+	return fakeQuantity{
+		system: f.system,
+		base:   f.base + other.base,
+	}
+}
+
+func TestSum(t *testing.T) {
+	type args struct {
+		values []fakeQuantity
+	}
+	tests := []struct {
+		name string
+		args args
+		want fakeQuantity
+	}{
+		{
+			name: "Should sum in the majority system",
+			args: args{
+				values: []fakeQuantity{{Metric, 1}, {Imperial, 2}, {Imperial, 3}},
+			},
+			want: fakeQuantity{Imperial, 6},
+		},
+		{
+			name: "Should prefer the first system on ties",
+			args: args{
+				values: []fakeQuantity{{USCustomary, 1}, {Metric, 2}},
+			},
+			want: fakeQuantity{USCustomary, 3},
+		},
+		{
+			name: "Should return zero value if empty",
+			args: args{
+				values: nil,
+			},
+			want: fakeQuantity{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Sum(tt.args.values...); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Sum() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMean(t *testing.T) {
+	type args struct {
+		values []fakeQuantity
+	}
+	tests := []struct {
+		name string
+		args args
+		want fakeQuantity
+	}{
+		{
+			name: "Should average in the majority system",
+			args: args{
+				values: []fakeQuantity{{Metric, 1}, {Metric, 2}, {Imperial, 6}},
+			},
+			want: fakeQuantity{Metric, 3},
+		},
+		{
+			name: "Should return zero value if empty",
+			args: args{
+				values: nil,
+			},
+			want: fakeQuantity{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Mean(tt.args.values...); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Mean() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMedian(t *testing.T) {
+	type args struct {
+		values []fakeQuantity
+	}
+	tests := []struct {
+		name string
+		args args
+		want fakeQuantity
+	}{
+		{
+			name: "Should return the middle value",
+			args: args{
+				values: []fakeQuantity{{Metric, 9}, {Metric, 1}, {Imperial, 4}},
+			},
+			want: fakeQuantity{Metric, 4},
+		},
+		{
+			name: "Should average the two middle values",
+			args: args{
+				values: []fakeQuantity{{Metric, 9}, {Metric, 1}, {Metric, 4}, {Metric, 2}},
+			},
+			want: fakeQuantity{Metric, 3},
+		},
+		{
+			name: "Should return zero value if empty",
+			args: args{
+				values: nil,
+			},
+			want: fakeQuantity{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Median(tt.args.values...); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Median() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMedian_keepsInput(t *testing.T) {
+	values := []fakeQuantity{{Metric, 9}, {Metric, 1}, {Metric, 4}}
+	Median(values...)
+	want := []fakeQuantity{{Metric, 9}, {Metric, 1}, {Metric, 4}}
+	if !reflect.DeepEqual(values, want) {
+		t.Errorf("Median() changed input to %v, want %v", values, want)
+	}
+}
+
+func TestStdDev(t *testing.T) {
+	type args struct {
+		values []fakeQuantity
+	}
+	tests := []struct {
+		name string
+		args args
+		want fakeQuantity
+	}{
+		{
+			name: "Should return the population standard deviation",
+			args: args{
+				values: []fakeQuantity{{Metric, 2}, {Metric, 4}, {Metric, 4}, {Metric, 4}, {Metric, 5}, {Metric, 5}, {Metric, 7}, {Metric, 9}},
+			},
+			want: fakeQuantity{Metric, 2},
+		},
+		{
+			name: "Should return zero value if empty",
+			args: args{
+				values: nil,
+			},
+			want: fakeQuantity{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := StdDev(tt.args.values...); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("StdDev() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package measure
+
+import "sort"
+
+type (
+	Comparable[T any] interface {
+		Measurable
+		Compare(other T) int
+	}
+)
+
+// Sort sorts values in ascending order, keeping the original order of
+// equal values.
+func Sort[T Comparable[T]](values []T) {
+	sort.SliceStable(values, func(i, j int) bool {
+		return values[i].Compare(values[j]) < 0
+	})
+}
+
+// Min returns the smallest of values, or the zero value if there are none.
+func Min[T Comparable[T]](values ...T) T {
+	var min T
+	for i, value := range values {
+		if i == 0 || value.Compare(min) < 0 {
+			min = value
+		}
+	}
+
+	return min
+}
+
+// Max returns the largest of values, or the zero value if there are none.
+func Max[T Comparable[T]](values ...T) T {
+	var max T
+	for i, value := range values {
+		if i == 0 || value.Compare(max) > 0 {
+			max = value
+		}
+	}
+
+	return max
+}
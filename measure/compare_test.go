@@ -0,0 +1,125 @@
+package measure
+
+import (
+	"reflect"
+	"testing"
+)
+
+type (
+	fakeComparable float64
+)
+
+func (f fakeComparable) IsZero() bool {
+	return f == 0
+}
+
+func (f fakeComparable) Compare(other fakeComparable) int {
+	switch {
+	case f < other:
+		return -1
+	case f > other:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func TestSort(t *testing.T) {
+	type args struct {
+		values []fakeComparable
+	}
+	tests := []struct {
+		name string
+		args args
+		want []fakeComparable
+	}{
+		{
+			name: "Should sort in ascending order",
+			args: args{
+				values: []fakeComparable{3, -1, 2, 0},
+			},
+			want: []fakeComparable{-1, 0, 2, 3},
+		},
+		{
+			name: "Should keep an empty slice",
+			args: args{
+				values: []fakeComparable{},
+			},
+			want: []fakeComparable{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			Sort(tt.args.values)
+			if !reflect.DeepEqual(tt.args.values, tt.want) {
+				t.Errorf("Sort() = %v, want %v", tt.args.values, tt.want)
+			}
+		})
+	}
+}
+
+func TestMin(t *testing.T) {
+	type args struct {
+		values []fakeComparable
+	}
+	tests := []struct {
+		name string
+		args args
+		want fakeComparable
+	}{
+		{
+			name: "Should return the smallest value",
+			args: args{
+				values: []fakeComparable{3, -1, 2},
+			},
+			want: -1,
+		},
+		{
+			name: "Should return zero value if empty",
+			args: args{
+				values: nil,
+			},
+			want: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Min(tt.args.values...); got != tt.want {
+				t.Errorf("Min() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMax(t *testing.T) {
+	type args struct {
+		values []fakeComparable
+	}
+	tests := []struct {
+		name string
+		args args
+		want fakeComparable
+	}{
+		{
+			name: "Should return the largest value",
+			args: args{
+				values: []fakeComparable{-3, 5, 2},
+			},
+			want: 5,
+		},
+		{
+			name: "Should return zero value if empty",
+			args: args{
+				values: nil,
+			},
+			want: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Max(tt.args.values...); got != tt.want {
+				t.Errorf("Max() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package measure
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var (
+	numberRegex = regexp.MustCompile(numberPattern)
+)
+
+// ParseCompound parses input with parse, also accepting expressions of
+// several terms such as "1 lb 4 oz", which are summed. A leading sign
+// applies to every term, so "-1 lb 4 oz" is minus 20 ounces.
+func ParseCompound[T Additive[T]](parse func(input string) (T, error), input string) (T, error) {
+	parsed, err := parse(input)
+	if !errors.Is(err, ErrUnknownUnit) {
+		return parsed, err
+	}
+
+	trimmed := strings.TrimSpace(input)
+	offset := strings.Index(input, trimmed)
+	indexes := termIndexes(trimmed)
+	if len(indexes) < 2 {
+		return parsed, err
+	}
+
+	sign := trimmed[:indexes[0][0]]
+	if sign != "" && sign != "+" && sign != "-" && sign != unicodeMinus {
+		return parsed, err
+	}
+
+	var sum T
+	for i, index := range indexes {
+		end := len(trimmed)
+		if i+1 < len(indexes) {
+			end = indexes[i+1][0]
+		}
+
+		term, termErr := parse(sign + trimmed[index[0]:end])
+		var parseErr *ParseError
+		if errors.As(termErr, &parseErr) {
+			parseErr.Input = input
+			parseErr.Position += offset + index[0] - len(sign)
+		}
+		if termErr != nil {
+			return term, termErr
+		}
+
+		if i == 0 {
+			sum = term
+		} else {
+			sum = sum.Add(term)
+		}
+	}
+
+	return sum, nil
+}
+
+// termIndexes returns the numbers of input that start a term, which are the
+// first one and those following a space, so "m3" in "1 m3 200 l" is a unit.
+func termIndexes(input string) [][]int {
+	var indexes [][]int
+	for _, index := range numberRegex.FindAllStringIndex(input, -1) {
+		previous, _ := utf8.DecodeLastRuneInString(input[:index[0]])
+		if len(indexes) == 0 || unicode.IsSpace(previous) {
+			indexes = append(indexes, index)
+		}
+	}
+
+	return indexes
+}
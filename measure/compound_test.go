@@ -0,0 +1,122 @@
+package measure
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseCompound(t *testing.T) {
+	parsers := ParserMap[fakeQuantity]{
+		"foo": func(value float64) fakeQuantity {
+			return fakeQuantity{system: Imperial, base: value * 10}
+		},
+		"bar": func(value float64) fakeQuantity {
+			return fakeQuantity{system: Metric, base: value}
+		},
+		"baz qux": func(value float64) fakeQuantity {
+			return fakeQuantity{system: Metric, base: value * 100}
+		},
+		"m3": func(value float64) fakeQuantity {
+			return fakeQuantity{system: Metric, base: value * 1000}
+		},
+	}
+	type args struct {
+		input string
+	}
+	tests := []struct {
+		name         string
+		args         args
+		want         fakeQuantity
+		wantErr      error
+		wantPosition int
+	}{
+		{
+			name: "Should parse a single term",
+			args: args{
+				input: "2 foo",
+			},
+			want: fakeQuantity{system: Imperial, base: 20},
+		},
+		{
+			name: "Should sum every term in the system of the first one",
+			args: args{
+				input: "1 foo 4 bar",
+			},
+			want: fakeQuantity{system: Imperial, base: 14},
+		},
+		{
+			name: "Should parse terms without spaces and with multi-word units",
+			args: args{
+				input: " 1baz qux 2foo 3.5bar ",
+			},
+			want: fakeQuantity{system: Metric, base: 123.5},
+		},
+		{
+			name: "Should keep digits inside units",
+			args: args{
+				input: "1 m3 200 bar",
+			},
+			want: fakeQuantity{system: Metric, base: 1200},
+		},
+		{
+			name: "Should apply a leading minus to every term",
+			args: args{
+				input: "-1 foo 4 bar",
+			},
+			want: fakeQuantity{system: Imperial, base: -14},
+		},
+		{
+			name: "Should apply a leading unicode minus to every term",
+			args: args{
+				input: "−1 foo 4 bar",
+			},
+			want: fakeQuantity{system: Imperial, base: -14},
+		},
+		{
+			name: "Should return the error of the faulty term",
+			args: args{
+				input: " 1 foo 4 xx",
+			},
+			want:         fakeQuantity{},
+			wantErr:      ErrUnknownUnit,
+			wantPosition: 9,
+		},
+		{
+			name: "Should return the error of the single term",
+			args: args{
+				input: "1 xx",
+			},
+			want:         fakeQuantity{},
+			wantErr:      ErrUnknownUnit,
+			wantPosition: 2,
+		},
+		{
+			name: "Should not parse terms after a malformed sign",
+			args: args{
+				input: "x1 foo 4 bar",
+			},
+			want:         fakeQuantity{},
+			wantErr:      ErrMalformedNumber,
+			wantPosition: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseCompound(parsers.ParseE, tt.args.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ParseCompound() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			var parseErr *ParseError
+			if errors.As(err, &parseErr) {
+				if parseErr.Position != tt.wantPosition || parseErr.Input != tt.args.input {
+					t.Errorf("ParseCompound() error = %+v, want position %v", parseErr, tt.wantPosition)
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseCompound() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
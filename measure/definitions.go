@@ -0,0 +1,361 @@
+package measure
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+)
+
+var (
+	ErrUnknownDimension   = errors.New("unknown dimension")
+	ErrCircularDefinition = errors.New("circular unit definition")
+	ErrInvalidDefinition  = errors.New("invalid unit definition")
+
+	dimensionsMutex sync.RWMutex
+	dimensions      = map[string]Dimension{}
+
+	namesMutex     sync.RWMutex
+	localizedNames = map[string]map[string]UnitName{}
+)
+
+type (
+	// Dimension is a kind of quantity, such as mass, whose units can be
+	// extended at runtime and found in texts. *Registry implements it.
+	Dimension interface {
+		ParseMeasurable(input string) (Measurable, error)
+		Convert(value Measurable, system System) (Measurable, bool)
+		Units() []string
+		Resolve(unit string) (factor float64, system System, err error)
+		RegisterUnit(name string, factor float64, system System, aliases ...string) error
+		RegisterAlias(alias, unit string) error
+	}
+
+	// Definitions describes additional units and aliases per dimension. It
+	// can be decoded from any format whose keys match the JSON ones, for
+	// instance YAML, and then given to RegisterDefinitions.
+	Definitions map[string]DimensionDefinitions
+
+	DimensionDefinitions struct {
+		Units   []UnitDefinition  `json:"units"`
+		Aliases map[string]string `json:"aliases"`
+	}
+
+	// UnitDefinition defines Name as Value times Unit, which is either a
+	// known unit or another definition of the same dimension. System is
+	// "metric", "imperial" or "us_customary" and defaults to the one of
+	// Unit. Names holds localized names by locale, such as
+	// {"pt-BR": {"singular": "balde", "plural": "baldes"}}, which parsing
+	// accepts too unless they are already known.
+	UnitDefinition struct {
+		Name    string              `json:"name"`
+		Value   float64             `json:"value"`
+		Unit    string              `json:"unit"`
+		System  string              `json:"system,omitempty"`
+		Aliases []string            `json:"aliases,omitempty"`
+		Names   map[string]UnitName `json:"names,omitempty"`
+	}
+
+	dimensionLoader struct {
+		name       string
+		dimension  Dimension
+		definition DimensionDefinitions
+		existing   map[string]bool
+		units      map[string]int
+		aliases    map[string]string
+		resolved   map[int]Unit
+		visiting   map[string]bool
+	}
+)
+
+// RegisterDimension makes a dimension available to definition files under
+// name. The mass, volume and temperature packages register themselves.
+func RegisterDimension(name string, dimension Dimension) {
+	dimensionsMutex.Lock()
+	defer dimensionsMutex.Unlock()
+	dimensions[strings.ToLower(name)] = dimension
+}
+
+// LoadDefinitions reads a JSON document such as
+//
+//	{"volume": {"units": [{"name": "bucket", "value": 18.9, "unit": "l"}]}}
+//
+// and registers its units and aliases.
+func LoadDefinitions(reader io.Reader) error {
+	decoder := json.NewDecoder(reader)
+	decoder.DisallowUnknownFields()
+
+	var definitions Definitions
+	if err := decoder.Decode(&definitions); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidDefinition, err)
+	}
+
+	return RegisterDefinitions(definitions)
+}
+
+// RegisterDefinitions validates every definition before registering any of
+// them, rejecting aliases that conflict with known units and definitions
+// that depend on themselves.
+func RegisterDefinitions(definitions Definitions) error {
+	names := make([]string, 0, len(definitions))
+	for name := range definitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	loaders := make([]*dimensionLoader, 0, len(names))
+	for _, name := range names {
+		dimensionsMutex.RLock()
+		dimension, ok := dimensions[strings.ToLower(name)]
+		dimensionsMutex.RUnlock()
+		if !ok {
+			return fmt.Errorf("%w %q", ErrUnknownDimension, name)
+		}
+
+		loader := newDimensionLoader(name, dimension, definitions[name])
+		if err := loader.validate(); err != nil {
+			return err
+		}
+
+		loaders = append(loaders, loader)
+	}
+
+	for _, loader := range loaders {
+		if err := loader.register(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// LocalizedName returns the names of unit in locale given by a definition.
+func LocalizedName(dimension, unit, locale string) (UnitName, bool) {
+	namesMutex.RLock()
+	defer namesMutex.RUnlock()
+	name, ok := localizedNames[localizedNameKey(dimension, unit)][strings.ToLower(locale)]
+	return name, ok
+}
+
+func newDimensionLoader(name string, dimension Dimension, definition DimensionDefinitions) *dimensionLoader {
+	existing := map[string]bool{}
+	for _, unit := range dimension.Units() {
+		existing[unit] = true
+	}
+
+	return &dimensionLoader{
+		name:       name,
+		dimension:  dimension,
+		definition: definition,
+		existing:   existing,
+		units:      map[string]int{},
+		aliases:    map[string]string{},
+		resolved:   map[int]Unit{},
+		visiting:   map[string]bool{},
+	}
+}
+
+func (l *dimensionLoader) validate() error {
+	for i, unit := range l.definition.Units {
+		if normalizeUnit(unit.Unit) == "" || unit.Value <= 0 {
+			return fmt.Errorf("%w: %s %q needs a positive value and a unit", ErrInvalidDefinition, l.name, unit.Name)
+		}
+
+		for _, key := range append([]string{unit.Name}, unit.Aliases...) {
+			if err := l.claim(key); err != nil {
+				return err
+			}
+			l.units[normalizeUnit(key)] = i
+		}
+	}
+
+	aliases := make([]string, 0, len(l.definition.Aliases))
+	for alias := range l.definition.Aliases {
+		aliases = append(aliases, alias)
+	}
+	sort.Strings(aliases)
+
+	for _, alias := range aliases {
+		if err := l.claim(alias); err != nil {
+			return err
+		}
+		l.aliases[normalizeUnit(alias)] = normalizeUnit(l.definition.Aliases[alias])
+	}
+
+	for i := range l.definition.Units {
+		if _, err := l.resolveUnit(i, nil); err != nil {
+			return err
+		}
+	}
+
+	for _, alias := range aliases {
+		if _, err := l.resolveAlias(normalizeUnit(alias), nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (l *dimensionLoader) claim(key string) error {
+	normalized := normalizeUnit(key)
+	if normalized == "" {
+		return fmt.Errorf("%w: empty %s unit name", ErrInvalidDefinition, l.name)
+	}
+
+	_, isUnit := l.units[normalized]
+	_, isAlias := l.aliases[normalized]
+	if l.existing[normalized] || isUnit || isAlias {
+		return fmt.Errorf("%w: %s %q is defined more than once", ErrUnitAlreadyRegistered, l.name, key)
+	}
+
+	return nil
+}
+
+func (l *dimensionLoader) resolveUnit(index int, path []string) (Unit, error) {
+	if unit, ok := l.resolved[index]; ok {
+		return unit, nil
+	}
+
+	definition := l.definition.Units[index]
+	key := normalizeUnit(definition.Name)
+	if l.visiting[key] {
+		return Unit{}, l.circular(append(path, definition.Name))
+	}
+
+	l.visiting[key] = true
+	defer delete(l.visiting, key)
+
+	factor, system, err := l.resolveFactor(normalizeUnit(definition.Unit), append(path, definition.Name))
+	if err != nil {
+		return Unit{}, err
+	}
+
+	if definition.System != "" {
+		var named systemName
+		if err := named.UnmarshalText([]byte(definition.System)); err != nil {
+			return Unit{}, fmt.Errorf("%w: %s %q: %s", ErrInvalidDefinition, l.name, definition.Name, err)
+		}
+		system = System(named)
+	}
+
+	unit := Unit{
+		Name:   definition.Name,
+		Factor: definition.Value * factor,
+		System: system,
+	}
+	l.resolved[index] = unit
+	return unit, nil
+}
+
+func (l *dimensionLoader) resolveFactor(key string, path []string) (float64, System, error) {
+	if index, ok := l.units[key]; ok {
+		unit, err := l.resolveUnit(index, path)
+		return unit.Factor, unit.System, err
+	}
+
+	if _, ok := l.aliases[key]; ok {
+		target, err := l.resolveAlias(key, path)
+		if err != nil {
+			return 0, 0, err
+		}
+		return l.resolveFactor(target, append(path, key))
+	}
+
+	factor, system, err := l.dimension.Resolve(key)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%s %q: %w", l.name, key, err)
+	}
+
+	return factor, system, nil
+}
+
+// resolveAlias follows the aliases of the definition up to a unit, returning
+// its key.
+func (l *dimensionLoader) resolveAlias(key string, path []string) (string, error) {
+	target, ok := l.aliases[key]
+	if !ok {
+		if _, isUnit := l.units[key]; isUnit || l.existing[key] {
+			return key, nil
+		}
+		return "", fmt.Errorf("%s %s: %w %q", l.name, strings.Join(path, " -> "), ErrUnknownUnit, key)
+	}
+
+	if l.visiting[key] {
+		return "", l.circular(append(path, key))
+	}
+
+	l.visiting[key] = true
+	defer delete(l.visiting, key)
+	return l.resolveAlias(target, append(path, key))
+}
+
+func (l *dimensionLoader) circular(path []string) error {
+	return fmt.Errorf("%w: %s %s", ErrCircularDefinition, l.name, strings.Join(path, " -> "))
+}
+
+func (l *dimensionLoader) register() error {
+	for i, definition := range l.definition.Units {
+		unit := l.resolved[i]
+		if err := l.dimension.RegisterUnit(unit.Name, unit.Factor, unit.System, definition.Aliases...); err != nil {
+			return err
+		}
+
+		if err := l.registerNames(definition); err != nil {
+			return err
+		}
+	}
+
+	for alias := range l.definition.Aliases {
+		target, _ := l.resolveAlias(normalizeUnit(alias), nil)
+		if err := l.dimension.RegisterAlias(alias, target); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// registerNames stores the localized names of definition and makes them
+// parse as aliases of its unit, skipping the ones already known.
+func (l *dimensionLoader) registerNames(definition UnitDefinition) error {
+	if len(definition.Names) == 0 {
+		return nil
+	}
+
+	key := localizedNameKey(l.name, definition.Name)
+	namesMutex.Lock()
+	if localizedNames[key] == nil {
+		localizedNames[key] = map[string]UnitName{}
+	}
+
+	var aliases []string
+	for locale, name := range definition.Names {
+		if name.Plural == "" {
+			name.Plural = name.Singular
+		}
+		localizedNames[key][strings.ToLower(locale)] = name
+		aliases = append(aliases, name.Singular, name.Plural, name.Abbreviation)
+	}
+	namesMutex.Unlock()
+
+	for _, alias := range aliases {
+		if normalizeUnit(alias) == "" {
+			continue
+		}
+
+		err := l.dimension.RegisterAlias(alias, definition.Name)
+		if err != nil && !errors.Is(err, ErrUnitAlreadyRegistered) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func localizedNameKey(dimension, unit string) string {
+	return strings.ToLower(dimension) + "/" + normalizeUnit(unit)
+}
@@ -0,0 +1,248 @@
+package measure
+
+import (
+	"errors"
+	"github.com/alancesar/gogram/numeric"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLoadDefinitions(t *testing.T) {
+	type args struct {
+		document string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		input   string
+		want    fakeQuantity
+		wantErr error
+	}{
+		{
+			name: "Should load units defined by known units",
+			args: args{
+				document: `{"fake": {"units": [{"name": "bucket", "value": 18.9, "unit": "foo", "aliases": ["buckets"]}]}}`,
+			},
+			input:   "2 buckets",
+			want:    fakeQuantity{system: Metric, base: 37.8},
+			wantErr: nil,
+		},
+		{
+			name: "Should load units defined by other definitions",
+			args: args{
+				document: `{"fake": {"units": [
+					{"name": "crate", "value": 2, "unit": "bucket", "system": "imperial"},
+					{"name": "bucket", "value": 10, "unit": "foo"}
+				]}}`,
+			},
+			input:   "3 crate",
+			want:    fakeQuantity{system: Imperial, base: 60},
+			wantErr: nil,
+		},
+		{
+			name: "Should load aliases of known and defined units",
+			args: args{
+				document: `{"fake": {
+					"units": [{"name": "bucket", "value": 10, "unit": "foo"}],
+					"aliases": {"pail": "bucket", "Fl.Foo.": "foo", "bkt": "pail"}
+				}}`,
+			},
+			input:   "2 bkt",
+			want:    fakeQuantity{system: Metric, base: 20},
+			wantErr: nil,
+		},
+		{
+			name: "Should return error for unknown dimensions",
+			args: args{
+				document: `{"length": {"aliases": {"ft": "foot"}}}`,
+			},
+			wantErr: ErrUnknownDimension,
+		},
+		{
+			name: "Should return error for aliases conflicting with known units",
+			args: args{
+				document: `{"fake": {"aliases": {"FOO": "foo"}}}`,
+			},
+			wantErr: ErrUnitAlreadyRegistered,
+		},
+		{
+			name: "Should return error for units defined twice",
+			args: args{
+				document: `{"fake": {"units": [
+					{"name": "bucket", "value": 10, "unit": "foo"},
+					{"name": "pail", "value": 10, "unit": "foo", "aliases": ["bucket"]}
+				]}}`,
+			},
+			wantErr: ErrUnitAlreadyRegistered,
+		},
+		{
+			name: "Should return error for circular units",
+			args: args{
+				document: `{"fake": {"units": [
+					{"name": "bucket", "value": 2, "unit": "pail"},
+					{"name": "pail", "value": 0.5, "unit": "bucket"}
+				]}}`,
+			},
+			wantErr: ErrCircularDefinition,
+		},
+		{
+			name: "Should return error for circular aliases",
+			args: args{
+				document: `{"fake": {"aliases": {"bucket": "pail", "pail": "bucket"}}}`,
+			},
+			wantErr: ErrCircularDefinition,
+		},
+		{
+			name: "Should return error for unknown units",
+			args: args{
+				document: `{"fake": {"units": [{"name": "bucket", "value": 2, "unit": "bar"}]}}`,
+			},
+			wantErr: ErrUnknownUnit,
+		},
+		{
+			name: "Should return error for unknown alias targets",
+			args: args{
+				document: `{"fake": {"aliases": {"bucket": "bar"}}}`,
+			},
+			wantErr: ErrUnknownUnit,
+		},
+		{
+			name: "Should return error for non positive values",
+			args: args{
+				document: `{"fake": {"units": [{"name": "bucket", "value": 0, "unit": "foo"}]}}`,
+			},
+			wantErr: ErrInvalidDefinition,
+		},
+		{
+			name: "Should return error for unknown fields",
+			args: args{
+				document: `{"fake": {"units": [{"name": "bucket", "factor": 2, "unit": "foo"}]}}`,
+			},
+			wantErr: ErrInvalidDefinition,
+		},
+		{
+			name: "Should return error for malformed documents",
+			args: args{
+				document: `{"fake": [}`,
+			},
+			wantErr: ErrInvalidDefinition,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry := newFakeRegistry()
+			RegisterDimension("fake", registry)
+
+			err := LoadDefinitions(strings.NewReader(tt.args.document))
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("LoadDefinitions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if got := registry.Units(); !reflect.DeepEqual(got, []string{"foo"}) {
+					t.Errorf("LoadDefinitions() registered %v on error", got)
+				}
+				return
+			}
+
+			got, err := registry.ParseE(tt.input)
+			if err != nil {
+				t.Errorf("ParseE() error = %v", err)
+			}
+			if got.system != tt.want.system || numeric.Compare(got.base, tt.want.base) != 0 {
+				t.Errorf("ParseE() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadDefinitions_circularMessage(t *testing.T) {
+	RegisterDimension("fake", newFakeRegistry())
+
+	err := LoadDefinitions(strings.NewReader(`{"fake": {"units": [
+		{"name": "bucket", "value": 2, "unit": "pail"},
+		{"name": "pail", "value": 0.5, "unit": "bucket"}
+	]}}`))
+	want := "circular unit definition: fake bucket -> pail -> bucket"
+	if err == nil || err.Error() != want {
+		t.Errorf("LoadDefinitions() error = %v, want %v", err, want)
+	}
+}
+
+func TestLocalizedName(t *testing.T) {
+	registry := newFakeRegistry()
+	RegisterDimension("fake", registry)
+	err := LoadDefinitions(strings.NewReader(`{"fake": {"units": [
+		{"name": "bucket", "value": 2, "unit": "foo", "names": {"pt-BR": {"singular": "balde", "plural": "baldes"}, "de": "Eimer"}}
+	]}}`))
+	if err != nil {
+		t.Fatalf("LoadDefinitions() error = %v", err)
+	}
+	if got, err := registry.ParseE("3 baldes"); err != nil || got.base != 6 {
+		t.Errorf("ParseE() got = %v, %v, want %v", got, err, 6)
+	}
+
+	type args struct {
+		dimension string
+		unit      string
+		locale    string
+	}
+	tests := []struct {
+		name   string
+		args   args
+		want   UnitName
+		wantOk bool
+	}{
+		{
+			name: "Should return the localized name",
+			args: args{
+				dimension: "fake",
+				unit:      "bucket",
+				locale:    "pt-br",
+			},
+			want:   UnitName{Singular: "balde", Plural: "baldes"},
+			wantOk: true,
+		},
+		{
+			name: "Should use a single name for both forms",
+			args: args{
+				dimension: "fake",
+				unit:      "bucket",
+				locale:    "de",
+			},
+			want:   UnitName{Singular: "Eimer", Plural: "Eimer"},
+			wantOk: true,
+		},
+		{
+			name: "Should return false for unknown locales",
+			args: args{
+				dimension: "fake",
+				unit:      "bucket",
+				locale:    "fr",
+			},
+			want:   UnitName{},
+			wantOk: false,
+		},
+		{
+			name: "Should return false for unknown units",
+			args: args{
+				dimension: "fake",
+				unit:      "foo",
+				locale:    "de",
+			},
+			want:   UnitName{},
+			wantOk: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := LocalizedName(tt.args.dimension, tt.args.unit, tt.args.locale)
+			if ok != tt.wantOk {
+				t.Errorf("LocalizedName() ok = %v, wantOk %v", ok, tt.wantOk)
+			}
+			if got != tt.want {
+				t.Errorf("LocalizedName() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
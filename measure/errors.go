@@ -0,0 +1,41 @@
+package measure
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrEmptyInput      = errors.New("empty input")
+	ErrMalformedNumber = errors.New("malformed number")
+	ErrUnknownUnit     = errors.New("unknown unit")
+
+	ErrInvalidUnit           = errors.New("invalid unit")
+	ErrUnitAlreadyRegistered = errors.New("unit already registered")
+)
+
+// ParseError describes why an input could not be parsed. Token is the
+// offending part of Input and Position its byte offset within Input.
+type ParseError struct {
+	Err      error
+	Input    string
+	Token    string
+	Position int
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("%s %q at position %d", e.Err, e.Token, e.Position)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+func newParseError(err error, input, token string, position int) *ParseError {
+	return &ParseError{
+		Err:      err,
+		Input:    input,
+		Token:    token,
+		Position: position,
+	}
+}
@@ -0,0 +1,61 @@
+package measure
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseError_Error(t *testing.T) {
+	tests := []struct {
+		name string
+		err  *ParseError
+		want string
+	}{
+		{
+			name: "Should describe the offending token and its position",
+			err: &ParseError{
+				Err:      ErrUnknownUnit,
+				Input:    "16 bar",
+				Token:    "bar",
+				Position: 3,
+			},
+			want: `unknown unit "bar" at position 3`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.err.Error(); got != tt.want {
+				t.Errorf("Error() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseError_Unwrap(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		target error
+		want   bool
+	}{
+		{
+			name:   "Should match the wrapped error",
+			err:    newParseError(ErrMalformedNumber, "x g", "x", 0),
+			target: ErrMalformedNumber,
+			want:   true,
+		},
+		{
+			name:   "Should not match another error",
+			err:    newParseError(ErrMalformedNumber, "x g", "x", 0),
+			target: ErrUnknownUnit,
+			want:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := errors.Is(tt.err, tt.target); got != tt.want {
+				t.Errorf("errors.Is() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package measure
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// maxUnitWords is the length of the longest unit name, such as "imp fl oz".
+const maxUnitWords = 3
+
+var (
+	findRegex = regexp.MustCompile(`[+\-\x{2212}]?(?:\d{1,3}(?:,\d{3})+(?:\.\d+)?|` + numberPattern + `)`)
+	wordRegex = regexp.MustCompile(`^\s*[^\s\d,;:!?()\[\]{}"]+`)
+
+	rangeRegex = regexp.MustCompile(`^\s*(?:-|\x{2013}|\s+to\s+)\s*`)
+
+	// ambiguousUnits are single-letter aliases FindAll ignores, since text
+	// rarely means them as units, as in "a 5k run" or "4 t" of sugar.
+	ambiguousUnits = map[string]bool{"c": true, "f": true, "k": true, "t": true}
+)
+
+type (
+	// Match is a quantity found in a text by FindAll. Start and End are the
+	// byte offsets of Text within it.
+	Match struct {
+		Dimension  string
+		Value      Measurable
+		Text       string
+		Start, End int
+	}
+)
+
+// FindAll returns every quantity of the registered dimensions found in text,
+// in order of appearance, such as "20 l" and "72°C" in "Heat 20 l of water
+// to 72°C". When units of several dimensions fit, the longest unit wins.
+// Both ends of ranges such as "65-68°C" are found, the first one holding
+// just its number as Text. Ambiguous single-letter units, such as "k" in "a
+// 5k run", are not found.
+func FindAll(text string) []Match {
+	dimensionsMutex.RLock()
+	names := make([]string, 0, len(dimensions))
+	snapshot := make(map[string]Dimension, len(dimensions))
+	for name, dimension := range dimensions {
+		names = append(names, name)
+		snapshot[name] = dimension
+	}
+	dimensionsMutex.RUnlock()
+	sort.Strings(names)
+
+	var matches []Match
+	end := 0
+	for _, index := range findRegex.FindAllStringIndex(text, -1) {
+		number := text[index[0]:index[1]]
+		index[0] += len(number) - len(strings.TrimLeftFunc(number, unicode.IsSpace))
+		if index[0] < end || !isBoundary(text, index[0]) {
+			continue
+		}
+
+		match, ok := matchAt(text, index, names, snapshot)
+		if !ok {
+			if first, second, ok := rangeAt(text, index, names, snapshot); ok {
+				matches = append(matches, first, second)
+				end = second.End
+			}
+			continue
+		}
+
+		if merged, ok := mergeCompound(text, matches, match, snapshot); ok {
+			matches[len(matches)-1] = merged
+		} else {
+			matches = append(matches, match)
+		}
+		end = match.End
+	}
+
+	return matches
+}
+
+func matchAt(text string, number []int, names []string, dimensions map[string]Dimension) (Match, bool) {
+	words := make([]int, 0, maxUnitWords)
+	for position := number[1]; len(words) < maxUnitWords; {
+		word := wordRegex.FindStringIndex(text[position:])
+		if word == nil {
+			break
+		}
+		position += word[1]
+		words = append(words, position)
+	}
+
+	for i := len(words) - 1; i >= 0; i-- {
+		candidates := []int{words[i]}
+		if trimmed := len(strings.TrimRight(text[number[1]:words[i]], ".")) + number[1]; trimmed != words[i] {
+			candidates = append(candidates, trimmed)
+		}
+
+		for _, end := range candidates {
+			if ambiguousUnits[normalizeUnit(text[number[1]:end])] {
+				continue
+			}
+
+			input := text[number[0]:end]
+			for _, name := range names {
+				if value, err := dimensions[name].ParseMeasurable(English.Normalize(input)); err == nil {
+					return Match{
+						Dimension: name,
+						Value:     value,
+						Text:      input,
+						Start:     number[0],
+						End:       end,
+					}, true
+				}
+			}
+		}
+	}
+
+	return Match{}, false
+}
+
+// rangeAt matches both ends of a range starting at number, such as "2-3
+// cups", the first one taking the unit of the second.
+func rangeAt(text string, number []int, names []string, dimensions map[string]Dimension) (Match, Match, bool) {
+	separator := rangeRegex.FindString(text[number[1]:])
+	start := number[1] + len(separator)
+	next := findRegex.FindStringIndex(text[start:])
+	if separator == "" || next == nil || next[0] != 0 {
+		return Match{}, Match{}, false
+	}
+
+	second, ok := matchAt(text, []int{start, start + next[1]}, names, dimensions)
+	if !ok {
+		return Match{}, Match{}, false
+	}
+
+	input := text[number[0]:number[1]] + text[start+next[1]:second.End]
+	value, err := dimensions[second.Dimension].ParseMeasurable(English.Normalize(input))
+	if err != nil {
+		return Match{}, Match{}, false
+	}
+
+	first := Match{
+		Dimension: second.Dimension,
+		Value:     value,
+		Text:      text[number[0]:number[1]],
+		Start:     number[0],
+		End:       number[1],
+	}
+	return first, second, true
+}
+
+// mergeCompound joins match with the previous one when both belong to the
+// same compound quantity, such as "1 lb" and "4 oz".
+func mergeCompound(text string, matches []Match, match Match, dimensions map[string]Dimension) (Match, bool) {
+	if len(matches) == 0 {
+		return Match{}, false
+	}
+
+	previous := matches[len(matches)-1]
+	if previous.Dimension != match.Dimension || strings.TrimSpace(text[previous.End:match.Start]) != "" {
+		return Match{}, false
+	}
+
+	input := text[previous.Start:match.End]
+	value, err := dimensions[match.Dimension].ParseMeasurable(English.Normalize(input))
+	if err != nil {
+		return Match{}, false
+	}
+
+	previous.Value, previous.Text, previous.End = value, input, match.End
+	return previous, true
+}
+
+// isBoundary reports whether a number starting at index is not part of a
+// word, as in "mp3", nor of another number, as in "1,5".
+func isBoundary(text string, index int) bool {
+	previous, size := utf8.DecodeLastRuneInString(text[:index])
+	if previous == ',' {
+		beforeComma, _ := utf8.DecodeLastRuneInString(text[:index-size])
+		return !unicode.IsDigit(beforeComma)
+	}
+	return index == 0 || !unicode.IsLetter(previous) && !unicode.IsDigit(previous) && previous != '.'
+}
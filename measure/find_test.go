@@ -0,0 +1,109 @@
+package measure
+
+import (
+	"github.com/alancesar/gogram/numeric"
+	"reflect"
+	"testing"
+)
+
+func TestFindAll(t *testing.T) {
+	newParser := func(system System, factor float64) Parser[fakeQuantity] {
+		return func(value float64) fakeQuantity {
+			return fakeQuantity{system: system, base: value * factor}
+		}
+	}
+	RegisterDimension("find-weight", NewRegistry(ParserMap[fakeQuantity]{
+		"g":  newParser(Metric, 1),
+		"kg": newParser(Metric, 1000),
+		"oz": newParser(Imperial, 28),
+	}))
+	RegisterDimension("find-liquid", NewRegistry(ParserMap[fakeQuantity]{
+		"l":     newParser(Metric, 1),
+		"fl oz": newParser(Imperial, 0.03),
+		"cup":   newParser(Imperial, 0.24),
+	}))
+
+	type args struct {
+		text string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []Match
+	}{
+		{
+			name: "Should find every quantity with its span",
+			args: args{
+				text: "Heat 20 l of water then add 5 kg malt",
+			},
+			want: []Match{
+				{Dimension: "find-liquid", Value: fakeQuantity{system: Metric, base: 20}, Text: "20 l", Start: 5, End: 9},
+				{Dimension: "find-weight", Value: fakeQuantity{system: Metric, base: 5000}, Text: "5 kg", Start: 28, End: 32},
+			},
+		},
+		{
+			name: "Should prefer the longest unit",
+			args: args{
+				text: "2 fl oz and 2 oz",
+			},
+			want: []Match{
+				{Dimension: "find-liquid", Value: fakeQuantity{system: Imperial, base: 0.06}, Text: "2 fl oz", Start: 0, End: 7},
+				{Dimension: "find-weight", Value: fakeQuantity{system: Imperial, base: 56}, Text: "2 oz", Start: 12, End: 16},
+			},
+		},
+		{
+			name: "Should find attached units, signs, fractions and trailing punctuation",
+			args: args{
+				text: "Use 1 1/2 cup, then -3g. Add ½ l.",
+			},
+			want: []Match{
+				{Dimension: "find-liquid", Value: fakeQuantity{system: Imperial, base: 0.36}, Text: "1 1/2 cup", Start: 4, End: 13},
+				{Dimension: "find-weight", Value: fakeQuantity{system: Metric, base: -3}, Text: "-3g", Start: 20, End: 23},
+				{Dimension: "find-liquid", Value: fakeQuantity{system: Metric, base: 0.5}, Text: "½ l", Start: 29, End: 33},
+			},
+		},
+		{
+			name: "Should keep thousands separators inside numbers",
+			args: args{
+				text: "Mix 1,000 g flour, 1,5 kg sugar",
+			},
+			want: []Match{
+				{Dimension: "find-weight", Value: fakeQuantity{system: Metric, base: 1000}, Text: "1,000 g", Start: 4, End: 11},
+			},
+		},
+		{
+			name: "Should find both ends of ranges",
+			args: args{
+				text: "Add 2-3 kg, then 1 to 2 cup",
+			},
+			want: []Match{
+				{Dimension: "find-weight", Value: fakeQuantity{system: Metric, base: 2000}, Text: "2", Start: 4, End: 5},
+				{Dimension: "find-weight", Value: fakeQuantity{system: Metric, base: 3000}, Text: "3 kg", Start: 6, End: 10},
+				{Dimension: "find-liquid", Value: fakeQuantity{system: Imperial, base: 0.24}, Text: "1", Start: 17, End: 18},
+				{Dimension: "find-liquid", Value: fakeQuantity{system: Imperial, base: 0.48}, Text: "2 cup", Start: 22, End: 27},
+			},
+		},
+		{
+			name: "Should ignore numbers without units or inside words",
+			args: args{
+				text: "Step 2: rest for 10 minutes in the mp3g room",
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FindAll(tt.args.text)
+			if len(got) != len(tt.want) {
+				t.Fatalf("FindAll() got = %+v, want %+v", got, tt.want)
+			}
+			for i := range got {
+				value, want := got[i].Value.(fakeQuantity), tt.want[i].Value.(fakeQuantity)
+				got[i].Value, tt.want[i].Value = nil, nil
+				if !reflect.DeepEqual(got[i], tt.want[i]) || value.system != want.system || numeric.Compare(value.base, want.base) != 0 {
+					t.Errorf("FindAll()[%d] got = %+v %v, want %+v %v", i, got[i], value, tt.want[i], want)
+				}
+			}
+		})
+	}
+}
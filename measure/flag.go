@@ -0,0 +1,21 @@
+package measure
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Set parses value into self for flag.Value implementations. Unknown units
+// are reported along with units, the symbols of the accepted ones.
+func Set[T Measurable](self *T, parse func(input string) (T, error), units []string, value string) error {
+	parsed, err := parse(value)
+	if errors.Is(err, ErrUnknownUnit) {
+		return fmt.Errorf("%w; accepted units: %s", err, strings.Join(units, ", "))
+	} else if err != nil {
+		return err
+	}
+
+	*self = parsed
+	return nil
+}
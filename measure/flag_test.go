@@ -0,0 +1,96 @@
+package measure
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestBuilderMap_Units(t *testing.T) {
+	tests := []struct {
+		name string
+		m    ParserMap[fakeStringMeasurable]
+		want []string
+	}{
+		{
+			name: "Should return sorted units",
+			m: ParserMap[fakeStringMeasurable]{
+				"foo": parseFn,
+				"bar": parseFn,
+				"baz": parseFn,
+			},
+			want: []string{"bar", "baz", "foo"},
+		},
+		{
+			name: "Should return empty if has no units",
+			m:    ParserMap[fakeStringMeasurable]{},
+			want: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.m.Units(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Units() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSet(t *testing.T) {
+	type args struct {
+		value string
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        fakeStringMeasurable
+		wantErr     error
+		wantMessage string
+	}{
+		{
+			name: "Should set properly",
+			args: args{
+				value: "16 foo",
+			},
+			want:    "16.00",
+			wantErr: nil,
+		},
+		{
+			name: "Should list the accepted units if receive an unknown unit",
+			args: args{
+				value: "16 baz",
+			},
+			want:        "current",
+			wantErr:     ErrUnknownUnit,
+			wantMessage: "accepted units: bar, foo",
+		},
+		{
+			name: "Should return error if receive a malformed number",
+			args: args{
+				value: "x foo",
+			},
+			want:    "current",
+			wantErr: ErrMalformedNumber,
+		},
+	}
+	parsers := ParserMap[fakeStringMeasurable]{
+		"foo": parseFn,
+		"bar": parseFn,
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := fakeStringMeasurable("current")
+			err := Set(&got, parsers.ParseE, parsers.Units(), tt.args.value)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Set() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !strings.Contains(err.Error(), tt.wantMessage) {
+				t.Errorf("Set() error = %v, want message %v", err, tt.wantMessage)
+			}
+			if got != tt.want {
+				t.Errorf("Set() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,219 @@
+package measure
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+)
+
+var (
+	approximationsMutex sync.RWMutex
+
+	// approximations maps vague amounts, such as "a pinch", to the quantity
+	// they are read as by ParseLenient. Values parsed from them are flagged
+	// as approximate.
+	approximations = map[string]Approximation{
+		"pinch":   {Value: 1.0 / 16, Unit: "tsp"},
+		"dash":    {Value: 1.0 / 8, Unit: "tsp"},
+		"smidgen": {Value: 1.0 / 32, Unit: "tsp"},
+	}
+
+	// approximateNumbers maps vague counts, such as "a couple", to the
+	// number they are read as by ParseLenient.
+	approximateNumbers = map[string]float64{
+		"couple":  2,
+		"few":     3,
+		"several": 4,
+	}
+
+	numberWords = map[string]float64{
+		"zero": 0, "one": 1, "two": 2, "three": 3, "four": 4, "five": 5,
+		"six": 6, "seven": 7, "eight": 8, "nine": 9, "ten": 10,
+		"eleven": 11, "twelve": 12, "thirteen": 13, "fourteen": 14,
+		"fifteen": 15, "sixteen": 16, "seventeen": 17, "eighteen": 18,
+		"nineteen": 19, "twenty": 20, "thirty": 30, "forty": 40,
+		"fifty": 50, "sixty": 60, "seventy": 70, "eighty": 80, "ninety": 90,
+	}
+
+	fractionWords = map[string]float64{
+		"half": 1.0 / 2, "halves": 1.0 / 2,
+		"third": 1.0 / 3, "thirds": 1.0 / 3,
+		"quarter": 1.0 / 4, "quarters": 1.0 / 4,
+		"eighth": 1.0 / 8, "eighths": 1.0 / 8,
+	}
+
+	multiplierWords = map[string]float64{
+		"dozen":    12,
+		"dozens":   12,
+		"hundred":  100,
+		"thousand": 1000,
+	}
+
+	degreesPrefix = regexp.MustCompile(`^(degrees?|deg)\s+`)
+	tokenRegex    = regexp.MustCompile(`[+\-\x{2212}]?[\d.¼½¾⅐⅑⅒⅓⅔⅕⅖⅗⅘⅙⅚⅛⅜⅝⅞]\S*|[^\s-]+`)
+)
+
+type (
+	Approximation struct {
+		Value float64
+		Unit  string
+	}
+
+	lenientNumber struct {
+		value       float64
+		approximate bool
+		unitStart   int
+	}
+)
+
+// RegisterApproximation makes ParseLenient read the vague amount word, such
+// as "pinch", as approximation.
+func RegisterApproximation(word string, approximation Approximation) {
+	approximationsMutex.Lock()
+	defer approximationsMutex.Unlock()
+	approximations[normalizeUnit(word)] = approximation
+}
+
+// RegisterApproximateNumber makes ParseLenient read the vague count word,
+// such as "couple", as value.
+func RegisterApproximateNumber(word string, value float64) {
+	approximationsMutex.Lock()
+	defer approximationsMutex.Unlock()
+	approximateNumbers[strings.ToLower(word)] = value
+}
+
+// ParseLenient parses inputs in the strict format as well as spelled out
+// ones such as "two cups", "half a pound", "a dozen grams" or "a pinch of
+// salt". Approximate reports whether vague amounts were read, either from
+// RegisterApproximation or from RegisterApproximateNumber.
+func (m ParserMap[T]) ParseLenient(input string) (parsed T, approximate bool, err error) {
+	parsed, err = m.ParseE(input)
+	if err == nil {
+		return parsed, false, nil
+	}
+
+	number, ok := readNumber(input)
+	if !ok {
+		return parsed, false, err
+	}
+
+	rawUnit := strings.TrimSpace(input[number.unitStart:])
+	unit := trimIngredient(strings.ToLower(rawUnit))
+	for _, candidate := range unitCandidates(unit) {
+		if approximation, ok := lookupApproximation(candidate); ok {
+			if builder, ok := m[normalizeUnit(approximation.Unit)]; ok {
+				return builder(number.value * approximation.Value), true, nil
+			}
+		}
+
+		if builder, ok := m[normalizeUnit(candidate)]; ok {
+			return builder(number.value), number.approximate, nil
+		}
+	}
+
+	var empty T
+	position := number.unitStart + strings.Index(input[number.unitStart:], rawUnit)
+	return empty, false, newParseError(ErrUnknownUnit, input, rawUnit, position)
+}
+
+// readNumber reads a spelled out number from the beginning of input.
+func readNumber(input string) (lenientNumber, bool) {
+	var (
+		result         lenientNumber
+		total, current float64
+		found          bool
+	)
+
+	for _, index := range tokenRegex.FindAllStringIndex(input, -1) {
+		token := strings.ToLower(input[index[0]:index[1]])
+		if value, ok := numberWords[token]; ok {
+			current += value
+		} else if value, ok := fractionWords[token]; ok {
+			current = orOne(current) * value
+		} else if value, ok := multiplierWords[token]; ok {
+			if value >= 1000 {
+				total += orOne(current) * value
+				current = 0
+			} else {
+				current = orOne(current) * value
+			}
+		} else if value, ok := lookupApproximateNumber(token); ok {
+			current = orOne(current) * value
+			result.approximate = true
+		} else if token == "a" || token == "an" {
+			current = orOne(current)
+		} else if token == "and" && found {
+			total += current
+			current = 0
+		} else if token == "of" && found {
+			result.unitStart = index[1]
+			continue
+		} else if value, err := parseNumber(token); err == nil && isDigit(token) {
+			current += value
+		} else {
+			break
+		}
+
+		found = true
+		result.unitStart = index[1]
+	}
+
+	result.value = total + current
+	return result, found
+}
+
+func lookupApproximation(word string) (Approximation, bool) {
+	approximationsMutex.RLock()
+	defer approximationsMutex.RUnlock()
+	approximation, ok := approximations[word]
+	return approximation, ok
+}
+
+func lookupApproximateNumber(word string) (float64, bool) {
+	approximationsMutex.RLock()
+	defer approximationsMutex.RUnlock()
+	value, ok := approximateNumbers[word]
+	return value, ok
+}
+
+func orOne(value float64) float64 {
+	if value == 0 {
+		return 1
+	}
+
+	return value
+}
+
+func isDigit(token string) bool {
+	return strings.IndexAny(token, "0123456789¼½¾⅐⅑⅒⅓⅔⅕⅖⅗⅘⅙⅚⅛⅜⅝⅞") >= 0
+}
+
+// trimIngredient drops what follows the unit in phrases like "cups of flour".
+func trimIngredient(unit string) string {
+	if index := strings.Index(unit, " of "); index >= 0 {
+		return unit[:index]
+	}
+
+	return unit
+}
+
+// unitCandidates returns unit along with the forms it may be registered
+// under, such as its singular or its name without "degrees".
+func unitCandidates(unit string) []string {
+	unit = normalizeUnit(unit)
+	candidates := []string{unit}
+	if stripped := degreesPrefix.ReplaceAllString(unit, ""); stripped != unit {
+		candidates = append(candidates, stripped)
+	}
+
+	for _, candidate := range candidates {
+		switch {
+		case strings.HasSuffix(candidate, "ches"), strings.HasSuffix(candidate, "shes"):
+			candidates = append(candidates, strings.TrimSuffix(candidate, "es"))
+		case strings.HasSuffix(candidate, "s"):
+			candidates = append(candidates, strings.TrimSuffix(candidate, "s"))
+		}
+	}
+
+	return candidates
+}
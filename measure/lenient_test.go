@@ -0,0 +1,194 @@
+package measure
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBuilderMap_ParseLenient(t *testing.T) {
+	parsers := ParserMap[fakeStringMeasurable]{
+		"cup":        parseFn,
+		"tsp":        parseFn,
+		"gram":       parseFn,
+		"fahrenheit": parseFn,
+	}
+	type args struct {
+		input string
+	}
+	tests := []struct {
+		name            string
+		args            args
+		want            fakeStringMeasurable
+		wantApproximate bool
+		wantErr         error
+	}{
+		{
+			name: "Should parse the strict format",
+			args: args{
+				input: "2 cup",
+			},
+			want: "2.00",
+		},
+		{
+			name: "Should parse spelled out numbers and plurals",
+			args: args{
+				input: "Two cups",
+			},
+			want: "2.00",
+		},
+		{
+			name: "Should parse hyphenated numbers",
+			args: args{
+				input: "twenty-five grams",
+			},
+			want: "25.00",
+		},
+		{
+			name: "Should parse large numbers",
+			args: args{
+				input: "two thousand five hundred grams",
+			},
+			want: "2500.00",
+		},
+		{
+			name: "Should parse halves",
+			args: args{
+				input: "half a cup",
+			},
+			want: "0.50",
+		},
+		{
+			name: "Should parse mixed spelled out numbers",
+			args: args{
+				input: "one and a half cups",
+			},
+			want: "1.50",
+		},
+		{
+			name: "Should parse plural fractions",
+			args: args{
+				input: "three quarters of a cup",
+			},
+			want: "0.75",
+		},
+		{
+			name: "Should parse dozens",
+			args: args{
+				input: "a dozen grams",
+			},
+			want: "12.00",
+		},
+		{
+			name: "Should parse half a dozen",
+			args: args{
+				input: "half a dozen grams",
+			},
+			want: "6.00",
+		},
+		{
+			name: "Should parse digits followed by ingredients",
+			args: args{
+				input: "1 1/2 cups of flour",
+			},
+			want: "1.50",
+		},
+		{
+			name: "Should parse degrees",
+			args: args{
+				input: "seventy degrees Fahrenheit",
+			},
+			want: "70.00",
+		},
+		{
+			name: "Should flag approximate numbers",
+			args: args{
+				input: "a couple of cups",
+			},
+			want:            "2.00",
+			wantApproximate: true,
+		},
+		{
+			name: "Should flag approximations",
+			args: args{
+				input: "a pinch of salt",
+			},
+			want:            "0.06",
+			wantApproximate: true,
+		},
+		{
+			name: "Should multiply approximations",
+			args: args{
+				input: "two dashes",
+			},
+			want:            "0.25",
+			wantApproximate: true,
+		},
+		{
+			name: "Should return error for unknown units",
+			args: args{
+				input: "two bowls",
+			},
+			want:    "",
+			wantErr: &ParseError{Err: ErrUnknownUnit, Input: "two bowls", Token: "bowls", Position: 4},
+		},
+		{
+			name: "Should return error without numbers",
+			args: args{
+				input: "some cups",
+			},
+			want:    "",
+			wantErr: &ParseError{Err: ErrMalformedNumber, Input: "some cups", Token: "some", Position: 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, approximate, err := parsers.ParseLenient(tt.args.input)
+			if !reflect.DeepEqual(err, tt.wantErr) {
+				t.Errorf("ParseLenient() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseLenient() got = %v, want %v", got, tt.want)
+			}
+			if approximate != tt.wantApproximate {
+				t.Errorf("ParseLenient() approximate = %v, want %v", approximate, tt.wantApproximate)
+			}
+		})
+	}
+}
+
+func TestRegisterApproximation(t *testing.T) {
+	defer func() {
+		approximationsMutex.Lock()
+		defer approximationsMutex.Unlock()
+		delete(approximations, "handful")
+	}()
+	RegisterApproximation("Handful", Approximation{Value: 2, Unit: "cup"})
+
+	parsers := ParserMap[fakeStringMeasurable]{"cup": parseFn}
+	got, approximate, err := parsers.ParseLenient("a handful of rice")
+	if err != nil {
+		t.Fatalf("ParseLenient() error = %v", err)
+	}
+	if got != "2.00" || !approximate {
+		t.Errorf("ParseLenient() got = %v, %v, want %v, %v", got, approximate, "2.00", true)
+	}
+}
+
+func TestRegisterApproximateNumber(t *testing.T) {
+	defer func() {
+		approximationsMutex.Lock()
+		defer approximationsMutex.Unlock()
+		delete(approximateNumbers, "umpteen")
+	}()
+	RegisterApproximateNumber("Umpteen", 10)
+
+	parsers := ParserMap[fakeStringMeasurable]{"cup": parseFn}
+	got, approximate, err := parsers.ParseLenient("umpteen cups")
+	if err != nil {
+		t.Fatalf("ParseLenient() error = %v", err)
+	}
+	if got != "10.00" || !approximate {
+		t.Errorf("ParseLenient() got = %v, %v, want %v, %v", got, approximate, "10.00", true)
+	}
+}
@@ -0,0 +1,168 @@
+package measure
+
+import (
+	"fmt"
+	"github.com/alancesar/gogram/numeric"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+var (
+	English = Locale{
+		Tag:       "en",
+		Decimal:   ".",
+		Group:     ",",
+		UnitSpace: " ",
+	}
+	BrazilianPortuguese = Locale{
+		Tag:           "pt-BR",
+		Decimal:       ",",
+		Group:         ".",
+		UnitSpace:     " ",
+		DegreeSpace:   " ",
+		PluralFromTwo: true,
+	}
+	German = Locale{
+		Tag:         "de",
+		Decimal:     ",",
+		Group:       ".",
+		UnitSpace:   "\u00a0",
+		DegreeSpace: "\u00a0",
+	}
+	French = Locale{
+		Tag:           "fr",
+		Decimal:       ",",
+		Group:         "\u202f",
+		UnitSpace:     "\u00a0",
+		DegreeSpace:   "\u00a0",
+		PluralFromTwo: true,
+	}
+
+	locales = []Locale{English, BrazilianPortuguese, German, French}
+
+	// numberRegexes caches the compiled numberRegex of each pair of
+	// separators.
+	numberRegexes sync.Map
+)
+
+type (
+	// Locale holds the conventions to parse and format quantities of a
+	// language, such as "1.234,5 g" in German.
+	Locale struct {
+		Tag string
+		// Decimal and Group are the decimal and the digit grouping
+		// separators.
+		Decimal, Group string
+		// UnitSpace goes between values and units and DegreeSpace between
+		// values and units starting with a degree sign, such as "°C".
+		UnitSpace, DegreeSpace string
+		// PluralFromTwo uses the singular for every value below two, as in
+		// French "1,5 kilogramme", instead of for one only.
+		PluralFromTwo bool
+	}
+)
+
+// LookupLocale returns the built-in locale of tag, such as "pt-BR". Tags
+// with an unknown region fall back to their language, so "de-AT" is German.
+func LookupLocale(tag string) (Locale, bool) {
+	for _, candidate := range localeFallbacks(tag) {
+		for _, locale := range locales {
+			if strings.EqualFold(locale.Tag, candidate) {
+				return locale, true
+			}
+		}
+	}
+
+	return Locale{}, false
+}
+
+// Normalize rewrites the numbers of input in the format ParseE expects, so
+// "1.234,5 g" in German becomes "1234.5 g".
+func (l Locale) Normalize(input string) string {
+	return l.numberRegex().ReplaceAllStringFunc(input, func(number string) string {
+		var builder strings.Builder
+		for _, r := range number {
+			switch {
+			case strings.ContainsRune(l.Decimal, r):
+				builder.WriteByte('.')
+			case unicode.IsDigit(r):
+				builder.WriteRune(r)
+			}
+		}
+		return builder.String()
+	})
+}
+
+// ParseLocalized parses input written in locale with parse. Errors refer to
+// the normalized input.
+func ParseLocalized[T Measurable](parse func(input string) (T, error), input string, locale Locale) (T, error) {
+	return parse(locale.Normalize(input))
+}
+
+// FormatNumber formats value with the separators of l.
+func (l Locale) FormatNumber(value float64) string {
+	formatted := numeric.Format(value)
+	sign := ""
+	if strings.HasPrefix(formatted, "-") {
+		sign, formatted = "-", formatted[1:]
+	}
+
+	integer, fraction := formatted, ""
+	if index := strings.IndexByte(formatted, '.'); index >= 0 {
+		integer, fraction = formatted[:index], formatted[index+1:]
+	}
+
+	var builder strings.Builder
+	builder.WriteString(sign)
+	for i, digit := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			builder.WriteString(l.Group)
+		}
+		builder.WriteRune(digit)
+	}
+
+	if fraction != "" {
+		builder.WriteString(l.Decimal)
+		builder.WriteString(fraction)
+	}
+
+	return builder.String()
+}
+
+// Format formats value in unit with the conventions of l.
+func (l Locale) Format(value float64, unit string) string {
+	space := l.UnitSpace
+	if strings.HasPrefix(unit, "°") {
+		space = l.DegreeSpace
+	}
+
+	return fmt.Sprintf("%s%s%s", l.FormatNumber(value), space, unit)
+}
+
+// numberRegex matches numbers grouped by thousands or with a decimal part.
+// Space-like group separators also accept plain spaces, as typed by users.
+func (l Locale) numberRegex() *regexp.Regexp {
+	key := [2]string{l.Decimal, l.Group}
+	if cached, ok := numberRegexes.Load(key); ok {
+		return cached.(*regexp.Regexp)
+	}
+
+	group := regexp.QuoteMeta(l.Group)
+	if strings.TrimSpace(l.Group) == "" {
+		group = `[ \x{a0}\x{202f}]`
+	}
+	decimal := regexp.QuoteMeta(l.Decimal)
+
+	compiled := regexp.MustCompile(`\d{1,3}(?:` + group + `\d{3})+(?:` + decimal + `\d+)?\b|\d+` + decimal + `\d+`)
+	cached, _ := numberRegexes.LoadOrStore(key, compiled)
+	return cached.(*regexp.Regexp)
+}
+
+// localeFallbacks returns tag followed by its language, such as "de-AT" and
+// "de".
+func localeFallbacks(tag string) []string {
+	tag = strings.ReplaceAll(tag, "_", "-")
+	return []string{tag, strings.SplitN(tag, "-", 2)[0]}
+}
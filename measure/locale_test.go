@@ -0,0 +1,302 @@
+package measure
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLookupLocale(t *testing.T) {
+	type args struct {
+		tag string
+	}
+	tests := []struct {
+		name   string
+		args   args
+		want   Locale
+		wantOk bool
+	}{
+		{
+			name: "Should find by tag ignoring case",
+			args: args{
+				tag: "pt-br",
+			},
+			want:   BrazilianPortuguese,
+			wantOk: true,
+		},
+		{
+			name: "Should accept underscores",
+			args: args{
+				tag: "pt_BR",
+			},
+			want:   BrazilianPortuguese,
+			wantOk: true,
+		},
+		{
+			name: "Should fall back to the language",
+			args: args{
+				tag: "de-AT",
+			},
+			want:   German,
+			wantOk: true,
+		},
+		{
+			name: "Should return false for unknown locales",
+			args: args{
+				tag: "ja",
+			},
+			want:   Locale{},
+			wantOk: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := LookupLocale(tt.args.tag)
+			if ok != tt.wantOk {
+				t.Errorf("LookupLocale() ok = %v, wantOk %v", ok, tt.wantOk)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("LookupLocale() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLocale_Normalize(t *testing.T) {
+	type args struct {
+		input string
+	}
+	tests := []struct {
+		name   string
+		locale Locale
+		args   args
+		want   string
+	}{
+		{
+			name:   "Should keep English numbers",
+			locale: English,
+			args: args{
+				input: "1.5 kg",
+			},
+			want: "1.5 kg",
+		},
+		{
+			name:   "Should drop English group separators",
+			locale: English,
+			args: args{
+				input: "1,234.5 g",
+			},
+			want: "1234.5 g",
+		},
+		{
+			name:   "Should replace Brazilian decimal separators",
+			locale: BrazilianPortuguese,
+			args: args{
+				input: "1,5 kg",
+			},
+			want: "1.5 kg",
+		},
+		{
+			name:   "Should normalize German grouped numbers",
+			locale: German,
+			args: args{
+				input: "-1.234,5 g",
+			},
+			want: "-1234.5 g",
+		},
+		{
+			name:   "Should normalize every number",
+			locale: German,
+			args: args{
+				input: "1 lb 4,5 oz",
+			},
+			want: "1 lb 4.5 oz",
+		},
+		{
+			name:   "Should accept plain spaces as French group separators",
+			locale: French,
+			args: args{
+				input: "1 234,5 g",
+			},
+			want: "1234.5 g",
+		},
+		{
+			name:   "Should accept narrow no-break spaces as French group separators",
+			locale: French,
+			args: args{
+				input: "1 234 567 g",
+			},
+			want: "1234567 g",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.locale.Normalize(tt.args.input); got != tt.want {
+				t.Errorf("Normalize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLocale_FormatNumber(t *testing.T) {
+	type args struct {
+		value float64
+	}
+	tests := []struct {
+		name   string
+		locale Locale
+		args   args
+		want   string
+	}{
+		{
+			name:   "Should format English numbers",
+			locale: English,
+			args: args{
+				value: 1234567.5,
+			},
+			want: "1,234,567.5",
+		},
+		{
+			name:   "Should format Brazilian numbers",
+			locale: BrazilianPortuguese,
+			args: args{
+				value: 1234.5,
+			},
+			want: "1.234,5",
+		},
+		{
+			name:   "Should format negative German numbers",
+			locale: German,
+			args: args{
+				value: -123456,
+			},
+			want: "-123.456",
+		},
+		{
+			name:   "Should format French numbers",
+			locale: French,
+			args: args{
+				value: 1234.25,
+			},
+			want: "1 234,25",
+		},
+		{
+			name:   "Should not group small numbers",
+			locale: German,
+			args: args{
+				value: 0.5,
+			},
+			want: "0,5",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.locale.FormatNumber(tt.args.value); got != tt.want {
+				t.Errorf("FormatNumber() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLocale_Format(t *testing.T) {
+	type args struct {
+		value float64
+		unit  string
+	}
+	tests := []struct {
+		name   string
+		locale Locale
+		args   args
+		want   string
+	}{
+		{
+			name:   "Should format English quantities",
+			locale: English,
+			args: args{
+				value: 1.5,
+				unit:  "kg",
+			},
+			want: "1.5 kg",
+		},
+		{
+			name:   "Should attach degrees in English",
+			locale: English,
+			args: args{
+				value: 72,
+				unit:  "°C",
+			},
+			want: "72°C",
+		},
+		{
+			name:   "Should space degrees in German",
+			locale: German,
+			args: args{
+				value: 72.5,
+				unit:  "°C",
+			},
+			want: "72,5\u00a0°C",
+		},
+		{
+			name:   "Should use no-break spaces in French",
+			locale: French,
+			args: args{
+				value: 1500,
+				unit:  "g",
+			},
+			want: "1 500 g",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.locale.Format(tt.args.value, tt.args.unit); got != tt.want {
+				t.Errorf("Format() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseLocalized(t *testing.T) {
+	parsers := ParserMap[fakeStringMeasurable]{
+		"foo": parseFn,
+	}
+	type args struct {
+		input  string
+		locale Locale
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    fakeStringMeasurable
+		wantErr bool
+	}{
+		{
+			name: "Should parse localized numbers",
+			args: args{
+				input:  "1.234,5 foo",
+				locale: German,
+			},
+			want:    "1234.50",
+			wantErr: false,
+		},
+		{
+			name: "Should return error for unknown units",
+			args: args{
+				input:  "1,5 bar",
+				locale: BrazilianPortuguese,
+			},
+			want:    "",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseLocalized(parsers.ParseE, tt.args.input, tt.args.locale)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseLocalized() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseLocalized() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -7,22 +7,41 @@ import (
 	"fmt"
 	"reflect"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 )
 
 const (
 	Metric System = iota
 	Imperial
+	USCustomary
 
 	valueIndex = 1
 	unitIndex  = 3
 
 	quotes = '"'
+
+	unicodeMinus  = "\u2212"
+	fractionSlash = "\u2044"
+
+	// numberPattern matches mixed numbers ("1 1/2"), fractions ("3/4"),
+	// fraction glyphs ("1½", "¾") and decimals.
+	numberPattern = `\d+\s+\d+[/\x{2044}]\d+|\d+[/\x{2044}]\d+|\d*\s*[¼½¾⅐⅑⅒⅓⅔⅕⅖⅗⅘⅙⅚⅛⅜⅝⅞]|\d*\.?\d+`
 )
 
 var (
-	regex = regexp.MustCompile(`(\d*\.?\d*)(\s?)(\D{1,7})`)
+	regex = regexp.MustCompile(`(?s)^([+\-\x{2212}]?(?:` + numberPattern + `|\d*\.?\d*))(\s*)(.*)$`)
+
+	fractionGlyphs = map[rune]float64{
+		'¼': 1.0 / 4, '½': 1.0 / 2, '¾': 3.0 / 4,
+		'⅐': 1.0 / 7, '⅑': 1.0 / 9, '⅒': 1.0 / 10,
+		'⅓': 1.0 / 3, '⅔': 2.0 / 3,
+		'⅕': 1.0 / 5, '⅖': 2.0 / 5, '⅗': 3.0 / 5, '⅘': 4.0 / 5,
+		'⅙': 1.0 / 6, '⅚': 5.0 / 6,
+		'⅛': 1.0 / 8, '⅜': 3.0 / 8, '⅝': 5.0 / 8, '⅞': 7.0 / 8,
+	}
 )
 
 type (
@@ -37,23 +56,57 @@ type (
 )
 
 func (m ParserMap[T]) Parse(input string) T {
+	parsed, _ := m.ParseE(input)
+	return parsed
+}
+
+func (m ParserMap[T]) ParseE(input string) (T, error) {
 	var empty T
 
-	elements := regex.FindStringSubmatch(input)
-	if elements == nil {
-		return empty
+	trimmed := strings.TrimSpace(input)
+	if trimmed == "" {
+		return empty, newParseError(ErrEmptyInput, input, input, 0)
+	}
+
+	offset := strings.Index(input, trimmed)
+	indexes := regex.FindStringSubmatchIndex(trimmed)
+
+	valueStart, valueEnd := indexes[2*valueIndex], indexes[2*valueIndex+1]
+	rawValue := trimmed[valueStart:valueEnd]
+	value, err := parseNumber(rawValue)
+	if err != nil {
+		token := rawValue
+		if token == "" {
+			token = strings.Fields(trimmed)[0]
+		}
+		return empty, newParseError(ErrMalformedNumber, input, token, offset+valueStart)
 	}
 
-	unit := strings.ToLower(elements[unitIndex])
-	value, _ := strconv.ParseFloat(elements[valueIndex], 64)
+	if rest := strings.TrimLeft(trimmed[valueEnd:], "0123456789./"+fractionSlash); rawValue != "" && len(rest) < len(trimmed)-valueEnd {
+		token := trimmed[valueStart : len(trimmed)-len(rest)]
+		return empty, newParseError(ErrMalformedNumber, input, token, offset+valueStart)
+	}
 
-	if builder, ok := m[unit]; !ok {
-		return empty
+	unitStart, unitEnd := indexes[2*unitIndex], indexes[2*unitIndex+1]
+	rawUnit := trimmed[unitStart:unitEnd]
+	if builder, ok := m[normalizeUnit(rawUnit)]; !ok {
+		return empty, newParseError(ErrUnknownUnit, input, rawUnit, offset+unitStart)
 	} else {
-		return builder(value)
+		return builder(value), nil
 	}
 }
 
+// Units returns the accepted unit aliases of m, sorted.
+func (m ParserMap[T]) Units() []string {
+	units := make([]string, 0, len(m))
+	for unit := range m {
+		units = append(units, unit)
+	}
+
+	sort.Strings(units)
+	return units
+}
+
 func Marshal(input Measurable) ([]byte, error) {
 	if stringer, ok := input.(fmt.Stringer); ok {
 		formatted := fmt.Sprintf(`"%s"`, stringer.String())
@@ -83,6 +136,83 @@ func Unmarshal[T Measurable](self *T, fromString func(input string) T, bytes []b
 	return nil
 }
 
+// UnmarshalE works like Unmarshal, but keeps self as is and returns the error
+// of parse when bytes can't be parsed. A JSON null also keeps self as is.
+func UnmarshalE[T Measurable](self *T, parse func(input string) (T, error), bytes []byte) error {
+	if string(bytes) == "null" {
+		return nil
+	}
+
+	raw, err := unquoteIfQuoted(string(bytes))
+	if err != nil {
+		return err
+	}
+
+	parsed, err := parse(raw)
+	if err != nil {
+		return err
+	}
+
+	*self = parsed
+	return nil
+}
+
+// parseNumber parses decimals and fractions such as "-1 1/2" or "1½".
+func parseNumber(raw string) (float64, error) {
+	raw = strings.Replace(raw, unicodeMinus, "-", 1)
+	sign := 1.0
+	if unsigned := strings.TrimLeft(raw, "+-"); len(raw)-len(unsigned) == 1 {
+		if raw[0] == '-' {
+			sign = -1
+		}
+		raw = unsigned
+	}
+
+	if glyph, size := utf8.DecodeLastRuneInString(raw); fractionGlyphs[glyph] != 0 {
+		whole := 0.0
+		if rawWhole := strings.TrimSpace(raw[:len(raw)-size]); rawWhole != "" {
+			parsed, err := strconv.ParseFloat(rawWhole, 64)
+			if err != nil {
+				return 0, err
+			}
+			whole = parsed
+		}
+		return sign * (whole + fractionGlyphs[glyph]), nil
+	}
+
+	fields := strings.Fields(strings.Replace(raw, fractionSlash, "/", 1))
+	if len(fields) == 0 || !strings.Contains(raw, "/") && !strings.Contains(raw, fractionSlash) {
+		value, err := strconv.ParseFloat(raw, 64)
+		return sign * value, err
+	}
+
+	whole := 0.0
+	if len(fields) == 2 {
+		parsed, err := strconv.ParseFloat(fields[0], 64)
+		if err != nil {
+			return 0, err
+		}
+		whole = parsed
+	}
+
+	parts := strings.SplitN(fields[len(fields)-1], "/", 2)
+	numerator, err := strconv.ParseFloat(parts[0], 64)
+	if err != nil {
+		return 0, err
+	}
+
+	denominator, err := strconv.ParseFloat(parts[1], 64)
+	if err != nil || denominator == 0 {
+		return 0, fmt.Errorf("invalid denominator in %q", raw)
+	}
+
+	return sign * (whole + numerator/denominator), nil
+}
+
+func normalizeUnit(unit string) string {
+	return strings.Join(strings.Fields(strings.ToLower(unit)), " ")
+}
+
 func isNumeric(input Measurable) bool {
 	switch reflect.ValueOf(input).Kind() {
 	case reflect.Float32, reflect.Float64, reflect.Int, reflect.Int32, reflect.Int64:
@@ -105,7 +235,7 @@ func unquoteIfQuoted(value interface{}) (string, error) {
 		return "", fmt.Errorf("could not convert value '%+v' to byte array of type '%T'", value, value)
 	}
 
-	if len(raw) > 2 && raw[0] == quotes && raw[len(raw)-1] == quotes {
+	if len(raw) >= 2 && raw[0] == quotes && raw[len(raw)-1] == quotes {
 		raw = raw[1 : len(raw)-1]
 	}
 
@@ -94,6 +94,252 @@ func TestBuilderMap_Parse(t *testing.T) {
 	}
 }
 
+func TestBuilderMap_ParseE(t *testing.T) {
+	type args struct {
+		input string
+	}
+	tests := []struct {
+		name    string
+		m       ParserMap[fakeStringMeasurable]
+		args    args
+		want    fakeStringMeasurable
+		wantErr error
+	}{
+		{
+			name: "Should parse properly",
+			m: ParserMap[fakeStringMeasurable]{
+				"foo": parseFn,
+			},
+			args: args{
+				input: "16 foo",
+			},
+			want:    "16.00",
+			wantErr: nil,
+		},
+		{
+			name: "Should return empty input error",
+			m: ParserMap[fakeStringMeasurable]{
+				"foo": parseFn,
+			},
+			args: args{
+				input: "   ",
+			},
+			want:    "",
+			wantErr: &ParseError{Err: ErrEmptyInput, Input: "   ", Token: "   ", Position: 0},
+		},
+		{
+			name: "Should return malformed number error",
+			m: ParserMap[fakeStringMeasurable]{
+				"foo": parseFn,
+			},
+			args: args{
+				input: "x foo",
+			},
+			want:    "",
+			wantErr: &ParseError{Err: ErrMalformedNumber, Input: "x foo", Token: "x", Position: 0},
+		},
+		{
+			name: "Should return unknown unit error",
+			m: ParserMap[fakeStringMeasurable]{
+				"foo": parseFn,
+			},
+			args: args{
+				input: " 16 bar",
+			},
+			want:    "",
+			wantErr: &ParseError{Err: ErrUnknownUnit, Input: " 16 bar", Token: "bar", Position: 4},
+		},
+		{
+			name: "Should return unknown unit error if have no symbol",
+			m: ParserMap[fakeStringMeasurable]{
+				"foo": parseFn,
+			},
+			args: args{
+				input: "16",
+			},
+			want:    "",
+			wantErr: &ParseError{Err: ErrUnknownUnit, Input: "16", Token: "", Position: 2},
+		},
+		{
+			name: "Should parse negative values",
+			m: ParserMap[fakeStringMeasurable]{
+				"foo": parseFn,
+			},
+			args: args{
+				input: "-16 foo",
+			},
+			want:    "-16.00",
+			wantErr: nil,
+		},
+		{
+			name: "Should parse values with explicit plus sign",
+			m: ParserMap[fakeStringMeasurable]{
+				"foo": parseFn,
+			},
+			args: args{
+				input: "+16 foo",
+			},
+			want:    "16.00",
+			wantErr: nil,
+		},
+		{
+			name: "Should parse values with unicode minus sign",
+			m: ParserMap[fakeStringMeasurable]{
+				"foo": parseFn,
+			},
+			args: args{
+				input: "\u221216.5 foo",
+			},
+			want:    "-16.50",
+			wantErr: nil,
+		},
+		{
+			name: "Should return malformed number error if have only a sign",
+			m: ParserMap[fakeStringMeasurable]{
+				"foo": parseFn,
+			},
+			args: args{
+				input: "- foo",
+			},
+			want:    "",
+			wantErr: &ParseError{Err: ErrMalformedNumber, Input: "- foo", Token: "-", Position: 0},
+		},
+		{
+			name: "Should parse units with several words",
+			m: ParserMap[fakeStringMeasurable]{
+				"foo bar": parseFn,
+			},
+			args: args{
+				input: "16  Foo   BAR",
+			},
+			want:    "16.00",
+			wantErr: nil,
+		},
+		{
+			name: "Should return unknown unit error if have trailing content",
+			m: ParserMap[fakeStringMeasurable]{
+				"foo": parseFn,
+			},
+			args: args{
+				input: "16 foo 4 foo",
+			},
+			want:    "",
+			wantErr: &ParseError{Err: ErrUnknownUnit, Input: "16 foo 4 foo", Token: "foo 4 foo", Position: 3},
+		},
+		{
+			name: "Should parse ASCII fractions",
+			m: ParserMap[fakeStringMeasurable]{
+				"foo": parseFn,
+			},
+			args: args{
+				input: "3/4 foo",
+			},
+			want:    "0.75",
+			wantErr: nil,
+		},
+		{
+			name: "Should parse mixed numbers",
+			m: ParserMap[fakeStringMeasurable]{
+				"foo": parseFn,
+			},
+			args: args{
+				input: "1 1/2 foo",
+			},
+			want:    "1.50",
+			wantErr: nil,
+		},
+		{
+			name: "Should parse negative mixed numbers",
+			m: ParserMap[fakeStringMeasurable]{
+				"foo": parseFn,
+			},
+			args: args{
+				input: "-1 1/2 foo",
+			},
+			want:    "-1.50",
+			wantErr: nil,
+		},
+		{
+			name: "Should parse fraction glyphs",
+			m: ParserMap[fakeStringMeasurable]{
+				"foo": parseFn,
+			},
+			args: args{
+				input: "½ foo",
+			},
+			want:    "0.50",
+			wantErr: nil,
+		},
+		{
+			name: "Should parse mixed fraction glyphs",
+			m: ParserMap[fakeStringMeasurable]{
+				"foo": parseFn,
+			},
+			args: args{
+				input: "1½foo",
+			},
+			want:    "1.50",
+			wantErr: nil,
+		},
+		{
+			name: "Should parse spaced mixed fraction glyphs",
+			m: ParserMap[fakeStringMeasurable]{
+				"foo": parseFn,
+			},
+			args: args{
+				input: "2 ⅓ foo",
+			},
+			want:    "2.33",
+			wantErr: nil,
+		},
+		{
+			name: "Should parse fraction slashes",
+			m: ParserMap[fakeStringMeasurable]{
+				"foo": parseFn,
+			},
+			args: args{
+				input: "1⁄8 foo",
+			},
+			want:    "0.12",
+			wantErr: nil,
+		},
+		{
+			name: "Should return malformed number error for zero denominators",
+			m: ParserMap[fakeStringMeasurable]{
+				"foo": parseFn,
+			},
+			args: args{
+				input: "1/0 foo",
+			},
+			want:    "",
+			wantErr: &ParseError{Err: ErrMalformedNumber, Input: "1/0 foo", Token: "1/0", Position: 0},
+		},
+		{
+			name: "Should return malformed number error for the whole numeric token",
+			m: ParserMap[fakeStringMeasurable]{
+				"foo": parseFn,
+			},
+			args: args{
+				input: " 1.2.3 foo",
+			},
+			want:    "",
+			wantErr: &ParseError{Err: ErrMalformedNumber, Input: " 1.2.3 foo", Token: "1.2.3", Position: 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.m.ParseE(tt.args.input)
+			if !reflect.DeepEqual(err, tt.wantErr) {
+				t.Errorf("ParseE() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseE() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestMarshal(t *testing.T) {
 	type args struct {
 		input Measurable
@@ -143,6 +389,105 @@ func TestMarshal(t *testing.T) {
 	}
 }
 
+func TestUnmarshal(t *testing.T) {
+	type args struct {
+		bytes []byte
+	}
+	tests := []struct {
+		name string
+		args args
+		want fakeStringMeasurable
+	}{
+		{
+			name: "Should unmarshal properly",
+			args: args{
+				bytes: []byte(`"16 foo"`),
+			},
+			want: "16.00",
+		},
+		{
+			name: "Should return the zero value if receive an unknown unit",
+			args: args{
+				bytes: []byte(`"16 bar"`),
+			},
+			want: "",
+		},
+	}
+	parsers := ParserMap[fakeStringMeasurable]{
+		"foo": parseFn,
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := fakeStringMeasurable("current")
+			if err := Unmarshal(&got, parsers.Parse, tt.args.bytes); err != nil {
+				t.Errorf("Unmarshal() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Unmarshal() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnmarshalE(t *testing.T) {
+	type args struct {
+		bytes []byte
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    fakeStringMeasurable
+		wantErr bool
+	}{
+		{
+			name: "Should unmarshal properly",
+			args: args{
+				bytes: []byte(`"16 foo"`),
+			},
+			want:    "16.00",
+			wantErr: false,
+		},
+		{
+			name: "Should keep the current value if receive null",
+			args: args{
+				bytes: []byte("null"),
+			},
+			want:    "current",
+			wantErr: false,
+		},
+		{
+			name: "Should return error if receive an empty string",
+			args: args{
+				bytes: []byte(`""`),
+			},
+			want:    "current",
+			wantErr: true,
+		},
+		{
+			name: "Should return error if receive an unknown unit",
+			args: args{
+				bytes: []byte(`"16 bar"`),
+			},
+			want:    "current",
+			wantErr: true,
+		},
+	}
+	parsers := ParserMap[fakeStringMeasurable]{
+		"foo": parseFn,
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := fakeStringMeasurable("current")
+			if err := UnmarshalE(&got, parsers.ParseE, tt.args.bytes); (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalE() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("UnmarshalE() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func Test_isNumeric(t *testing.T) {
 	type args struct {
 		input Measurable
@@ -0,0 +1,48 @@
+package measure
+
+import (
+	"fmt"
+	"github.com/alancesar/gogram/numeric"
+	"math"
+	"strings"
+)
+
+// mixedTolerance absorbs the error of dividing already rounded amounts.
+const mixedTolerance = 1e-9
+
+// FormatMixed formats value, given in base units, as whole amounts of units
+// plus a remainder in the last one, rounded to precision decimal places, such
+// as "1 lb 4.5 oz". Units must be sorted from the largest to the smallest
+// and zero amounts are omitted.
+func FormatMixed(value float64, units []Unit, precision int) string {
+	if len(units) == 0 {
+		return ""
+	}
+
+	smallest := units[len(units)-1]
+	remaining := numeric.Round(math.Abs(value)/smallest.Factor, precision)
+
+	parts := make([]string, 0, len(units))
+	for _, unit := range units[:len(units)-1] {
+		ratio := unit.Factor / smallest.Factor
+		whole := math.Floor(remaining/ratio + mixedTolerance)
+		if whole == 0 {
+			continue
+		}
+
+		remaining = math.Max(numeric.Round(remaining-whole*ratio, precision), 0)
+		parts = append(parts, fmt.Sprintf("%s %s", numeric.Format(whole), unit.Name))
+	}
+
+	isZero := len(parts) == 0 && remaining == 0
+	if remaining != 0 || isZero {
+		parts = append(parts, fmt.Sprintf("%s %s", numeric.Format(remaining), smallest.Name))
+	}
+
+	formatted := strings.Join(parts, " ")
+	if value < 0 && !isZero {
+		return "-" + formatted
+	}
+
+	return formatted
+}
@@ -0,0 +1,113 @@
+package measure
+
+import "testing"
+
+func TestFormatMixed(t *testing.T) {
+	units := []Unit{
+		{Name: "lb", Factor: 1},
+		{Name: "oz", Factor: 1.0 / 16},
+	}
+	type args struct {
+		value     float64
+		units     []Unit
+		precision int
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "Should split into whole units and remainder",
+			args: args{
+				value:     1.28125,
+				units:     units,
+				precision: 1,
+			},
+			want: "1 lb 4.5 oz",
+		},
+		{
+			name: "Should omit a zero remainder",
+			args: args{
+				value:     2,
+				units:     units,
+				precision: 1,
+			},
+			want: "2 lb",
+		},
+		{
+			name: "Should omit zero whole units",
+			args: args{
+				value:     0.25,
+				units:     units,
+				precision: 1,
+			},
+			want: "4 oz",
+		},
+		{
+			name: "Should carry when the remainder rounds up",
+			args: args{
+				value:     1.999,
+				units:     units,
+				precision: 1,
+			},
+			want: "2 lb",
+		},
+		{
+			name: "Should round the remainder",
+			args: args{
+				value:     1.0209,
+				units:     units,
+				precision: 0,
+			},
+			want: "1 lb",
+		},
+		{
+			name: "Should prefix negative values",
+			args: args{
+				value:     -1.25,
+				units:     units,
+				precision: 1,
+			},
+			want: "-1 lb 4 oz",
+		},
+		{
+			name: "Should format zero in the smallest unit",
+			args: args{
+				value:     -0.0001,
+				units:     units,
+				precision: 1,
+			},
+			want: "0 oz",
+		},
+		{
+			name: "Should skip intermediate zero units",
+			args: args{
+				value: 2000.5,
+				units: []Unit{
+					{Name: "ton", Factor: 2000},
+					{Name: "lb", Factor: 1},
+					{Name: "oz", Factor: 1.0 / 16},
+				},
+				precision: 1,
+			},
+			want: "1 ton 8 oz",
+		},
+		{
+			name: "Should return empty without units",
+			args: args{
+				value:     1,
+				units:     nil,
+				precision: 1,
+			},
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatMixed(tt.args.value, tt.args.units, tt.args.precision); got != tt.want {
+				t.Errorf("FormatMixed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package measure
+
+import (
+	"encoding/json"
+	"github.com/alancesar/gogram/numeric"
+	"math"
+	"strconv"
+	"strings"
+)
+
+type (
+	// UnitName holds the long forms of a unit in a language and its
+	// abbreviation, which is the unit symbol when empty.
+	UnitName struct {
+		Singular     string `json:"singular"`
+		Plural       string `json:"plural,omitempty"`
+		Abbreviation string `json:"abbreviation,omitempty"`
+	}
+
+	// NameCatalog maps unit symbols to their names by locale tag, such as
+	// "pt-BR".
+	NameCatalog map[string]map[string]UnitName
+)
+
+// UnmarshalJSON also accepts a single name as a string, used for both the
+// singular and the plural.
+func (n *UnitName) UnmarshalJSON(bytes []byte) error {
+	var name string
+	if err := json.Unmarshal(bytes, &name); err == nil {
+		*n = UnitName{Singular: name, Plural: name}
+		return nil
+	}
+
+	type plain UnitName
+	return json.Unmarshal(bytes, (*plain)(n))
+}
+
+// Lookup returns the names of unit in locale, falling back from region to
+// language.
+func (c NameCatalog) Lookup(unit string, locale Locale) (UnitName, bool) {
+	names := c[unit]
+	for _, tag := range localeFallbacks(locale.Tag) {
+		for candidate, name := range names {
+			if strings.EqualFold(candidate, tag) {
+				return name, true
+			}
+		}
+	}
+
+	return UnitName{}, false
+}
+
+// Abbreviation returns the abbreviation of unit in locale, such as "EL" for
+// a tablespoon in German, or unit itself.
+func (c NameCatalog) Abbreviation(unit string, locale Locale) string {
+	if name, ok := c.Lookup(unit, locale); ok && name.Abbreviation != "" {
+		return name.Abbreviation
+	}
+
+	return unit
+}
+
+// FormatLong formats value followed by the long name of unit in locale, such
+// as "2 quilogramas". Units missing from c use the names given by the
+// definitions of dimension, and their abbreviation otherwise.
+func (c NameCatalog) FormatLong(value float64, dimension, unit string, locale Locale) string {
+	name, ok := c.Lookup(unit, locale)
+	if !ok {
+		name, ok = definedName(dimension, unit, locale)
+	}
+
+	if !ok {
+		return locale.Format(value, c.Abbreviation(unit, locale))
+	}
+
+	long := name.Plural
+	if locale.singular(value) {
+		long = name.Singular
+	}
+
+	return locale.FormatNumber(value) + locale.UnitSpace + long
+}
+
+// RegisterNames makes m accept the names and abbreviations of catalog,
+// skipping the ones it already knows, such as "liter". It is not safe for
+// concurrent use; Registry.RegisterNames is.
+func (m ParserMap[T]) RegisterNames(catalog NameCatalog) {
+	for unit, names := range catalog {
+		parser, ok := m[normalizeUnit(unit)]
+		if !ok {
+			continue
+		}
+
+		for _, name := range names {
+			for _, alias := range []string{name.Singular, name.Plural, name.Abbreviation} {
+				key := normalizeUnit(alias)
+				if _, known := m[key]; key != "" && !known {
+					m[key] = parser
+				}
+			}
+		}
+	}
+}
+
+// definedName returns the name of unit in locale given by a definition.
+func definedName(dimension, unit string, locale Locale) (UnitName, bool) {
+	for _, tag := range localeFallbacks(locale.Tag) {
+		if name, ok := LocalizedName(dimension, unit, tag); ok {
+			return name, true
+		}
+	}
+
+	return UnitName{}, false
+}
+
+// singular reports whether value, as formatted, takes the singular form in l.
+func (l Locale) singular(value float64) bool {
+	rounded, err := strconv.ParseFloat(numeric.Format(value), 64)
+	if err != nil {
+		return false
+	}
+
+	if l.PluralFromTwo {
+		return math.Abs(rounded) < 2
+	}
+
+	return math.Abs(rounded) == 1
+}
@@ -0,0 +1,199 @@
+package measure
+
+import (
+	"reflect"
+	"testing"
+)
+
+var fakeNames = NameCatalog{
+	"foo": {
+		"en":    {Singular: "foobar", Plural: "foobars"},
+		"pt-BR": {Singular: "fubá", Plural: "fubás", Abbreviation: "fb"},
+		"fr":    {Singular: "fou", Plural: "fous"},
+	},
+}
+
+func TestNameCatalog_Lookup(t *testing.T) {
+	type args struct {
+		unit   string
+		locale Locale
+	}
+	tests := []struct {
+		name   string
+		args   args
+		want   UnitName
+		wantOk bool
+	}{
+		{
+			name: "Should find names by locale",
+			args: args{
+				unit:   "foo",
+				locale: BrazilianPortuguese,
+			},
+			want:   UnitName{Singular: "fubá", Plural: "fubás", Abbreviation: "fb"},
+			wantOk: true,
+		},
+		{
+			name: "Should fall back to the language",
+			args: args{
+				unit:   "foo",
+				locale: Locale{Tag: "fr-CA"},
+			},
+			want:   UnitName{Singular: "fou", Plural: "fous"},
+			wantOk: true,
+		},
+		{
+			name: "Should return false for missing locales",
+			args: args{
+				unit:   "foo",
+				locale: German,
+			},
+			want:   UnitName{},
+			wantOk: false,
+		},
+		{
+			name: "Should return false for unknown units",
+			args: args{
+				unit:   "bar",
+				locale: English,
+			},
+			want:   UnitName{},
+			wantOk: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := fakeNames.Lookup(tt.args.unit, tt.args.locale)
+			if ok != tt.wantOk {
+				t.Errorf("Lookup() ok = %v, wantOk %v", ok, tt.wantOk)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Lookup() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNameCatalog_FormatLong(t *testing.T) {
+	localizedNames[localizedNameKey("fake", "bar")] = map[string]UnitName{"pt-br": {Singular: "barra", Plural: "barras"}}
+	defer delete(localizedNames, localizedNameKey("fake", "bar"))
+
+	type args struct {
+		value  float64
+		unit   string
+		locale Locale
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "Should use the singular for one",
+			args: args{
+				value:  1,
+				unit:   "foo",
+				locale: English,
+			},
+			want: "1 foobar",
+		},
+		{
+			name: "Should use the plural for fractions in English",
+			args: args{
+				value:  1.5,
+				unit:   "foo",
+				locale: English,
+			},
+			want: "1.5 foobars",
+		},
+		{
+			name: "Should use the plural for zero in English",
+			args: args{
+				value:  0,
+				unit:   "foo",
+				locale: English,
+			},
+			want: "0 foobars",
+		},
+		{
+			name: "Should use the singular below two in French",
+			args: args{
+				value:  1.5,
+				unit:   "foo",
+				locale: French,
+			},
+			want: "1,5 fou",
+		},
+		{
+			name: "Should use the plural from two in Brazilian Portuguese",
+			args: args{
+				value:  -2,
+				unit:   "foo",
+				locale: BrazilianPortuguese,
+			},
+			want: "-2 fubás",
+		},
+		{
+			name: "Should use names given by definitions",
+			args: args{
+				value:  2,
+				unit:   "bar",
+				locale: BrazilianPortuguese,
+			},
+			want: "2 barras",
+		},
+		{
+			name: "Should keep the abbreviation of units without names",
+			args: args{
+				value:  2,
+				unit:   "foo",
+				locale: German,
+			},
+			want: "2 foo",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fakeNames.FormatLong(tt.args.value, "fake", tt.args.unit, tt.args.locale); got != tt.want {
+				t.Errorf("FormatLong() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParserMap_RegisterNames(t *testing.T) {
+	m := ParserMap[fakeStringMeasurable]{
+		"foo":    parseFn,
+		"foobar": func(value float64) fakeStringMeasurable { return "taken" },
+	}
+	m.RegisterNames(fakeNames)
+
+	tests := []struct {
+		name  string
+		input string
+		want  fakeStringMeasurable
+	}{
+		{
+			name:  "Should parse localized plurals",
+			input: "2 Fubás",
+			want:  "2.00",
+		},
+		{
+			name:  "Should parse localized abbreviations",
+			input: "2 fb",
+			want:  "2.00",
+		},
+		{
+			name:  "Should keep known units",
+			input: "2 foobar",
+			want:  "taken",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := m.Parse(tt.input); got != tt.want {
+				t.Errorf("Parse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package measure
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+type (
+	// Null represents a quantity that may be absent, telling a missing
+	// value apart from a zero one. It marshals to JSON null and is stored
+	// as SQL NULL when Valid is false. IsZero reports absence, so encoders
+	// honoring it (such as the omitzero JSON option) leave absent
+	// quantities out.
+	Null[T Measurable] struct {
+		Quantity T
+		Valid    bool
+	}
+)
+
+func NewNull[T Measurable](quantity T) Null[T] {
+	return Null[T]{
+		Quantity: quantity,
+		Valid:    true,
+	}
+}
+
+func (n Null[T]) IsZero() bool {
+	return !n.Valid
+}
+
+func (n Null[T]) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	if marshaler, ok := interface{}(n.Quantity).(json.Marshaler); ok {
+		return marshaler.MarshalJSON()
+	}
+
+	return Marshal(n.Quantity)
+}
+
+func (n *Null[T]) UnmarshalJSON(bytes []byte) error {
+	if string(bytes) == "null" {
+		*n = Null[T]{}
+		return nil
+	}
+
+	unmarshaler, ok := interface{}(&n.Quantity).(json.Unmarshaler)
+	if !ok {
+		return fmt.Errorf("could not unmarshal into value of type '%T'", n.Quantity)
+	}
+
+	if err := unmarshaler.UnmarshalJSON(bytes); err != nil {
+		return err
+	}
+
+	n.Valid = true
+	return nil
+}
+
+func (n Null[T]) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	if valuer, ok := interface{}(n.Quantity).(driver.Valuer); ok {
+		return valuer.Value()
+	}
+
+	return fmt.Sprint(n.Quantity), nil
+}
+
+func (n *Null[T]) Scan(src interface{}) error {
+	if src == nil {
+		*n = Null[T]{}
+		return nil
+	}
+
+	scanner, ok := interface{}(&n.Quantity).(sql.Scanner)
+	if !ok {
+		return fmt.Errorf("could not scan into value of type '%T'", n.Quantity)
+	}
+
+	if err := scanner.Scan(src); err != nil {
+		return err
+	}
+
+	n.Valid = true
+	return nil
+}
@@ -0,0 +1,202 @@
+package measure
+
+import (
+	"database/sql/driver"
+	"reflect"
+	"testing"
+)
+
+func TestNewNull(t *testing.T) {
+	tests := []struct {
+		name     string
+		quantity fakeStringMeasurable
+		want     Null[fakeStringMeasurable]
+	}{
+		{
+			name:     "Should create a valid value",
+			quantity: "abc",
+			want:     Null[fakeStringMeasurable]{Quantity: "abc", Valid: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewNull(tt.quantity); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewNull() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNull_IsZero(t *testing.T) {
+	tests := []struct {
+		name string
+		null Null[fakeNumberMeasurable]
+		want bool
+	}{
+		{
+			name: "Should return true if is not valid",
+			null: Null[fakeNumberMeasurable]{},
+			want: true,
+		},
+		{
+			name: "Should return false if holds a zero quantity",
+			null: NewNull(fakeNumberMeasurable(0)),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.null.IsZero(); got != tt.want {
+				t.Errorf("IsZero() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNull_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		null    Null[fakeStringMeasurable]
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name:    "Should marshal as null if is not valid",
+			null:    Null[fakeStringMeasurable]{},
+			want:    []byte("null"),
+			wantErr: false,
+		},
+		{
+			name:    "Should marshal the quantity if is valid",
+			null:    NewNull(fakeStringMeasurable("abc")),
+			want:    []byte(`"abc implements Stringer"`),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.null.MarshalJSON()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalJSON() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNull_UnmarshalJSON(t *testing.T) {
+	type args struct {
+		bytes []byte
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Null[fakeStringMeasurable]
+		wantErr bool
+	}{
+		{
+			name: "Should unmarshal null as not valid",
+			args: args{
+				bytes: []byte("null"),
+			},
+			want:    Null[fakeStringMeasurable]{},
+			wantErr: false,
+		},
+		{
+			name: "Should return error if the quantity is not an unmarshaler",
+			args: args{
+				bytes: []byte(`"abc"`),
+			},
+			want:    NewNull(fakeStringMeasurable("current")),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewNull(fakeStringMeasurable("current"))
+			if err := got.UnmarshalJSON(tt.args.bytes); (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UnmarshalJSON() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNull_Value(t *testing.T) {
+	tests := []struct {
+		name    string
+		null    Null[fakeStringMeasurable]
+		want    driver.Value
+		wantErr bool
+	}{
+		{
+			name:    "Should return nil if is not valid",
+			null:    Null[fakeStringMeasurable]{},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name:    "Should return the string representation if is valid",
+			null:    NewNull(fakeStringMeasurable("abc")),
+			want:    "abc implements Stringer",
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.null.Value()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Value() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Value() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNull_Scan(t *testing.T) {
+	type args struct {
+		src interface{}
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Null[fakeStringMeasurable]
+		wantErr bool
+	}{
+		{
+			name: "Should scan NULL as not valid",
+			args: args{
+				src: nil,
+			},
+			want:    Null[fakeStringMeasurable]{},
+			wantErr: false,
+		},
+		{
+			name: "Should return error if the quantity is not a scanner",
+			args: args{
+				src: "abc",
+			},
+			want:    NewNull(fakeStringMeasurable("current")),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewNull(fakeStringMeasurable("current"))
+			if err := got.Scan(tt.args.src); (err != nil) != tt.wantErr {
+				t.Errorf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Scan() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
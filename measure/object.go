@@ -0,0 +1,132 @@
+package measure
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/alancesar/gogram/numeric"
+	"strings"
+)
+
+var (
+	ErrSystemMismatch = errors.New("unit does not belong to system")
+
+	systemNames = map[System]string{
+		Metric:      "metric",
+		Imperial:    "imperial",
+		USCustomary: "us_customary",
+	}
+)
+
+type (
+	// ObjectMarshaler is a quantity that can marshal to a JSON object such
+	// as {"value":1.5,"unit":"kg","system":"metric"}.
+	ObjectMarshaler interface {
+		MarshalJSONObject() ([]byte, error)
+	}
+
+	// Object makes a quantity marshal to its JSON object form instead of a
+	// quoted string. Unmarshaling accepts both forms.
+	Object[T ObjectMarshaler] struct {
+		Quantity T
+	}
+
+	object struct {
+		Value  json.Number `json:"value"`
+		Unit   string      `json:"unit"`
+		System *systemName `json:"system"`
+	}
+
+	// systemName names a System in object forms and definitions, as in
+	// "metric", leaving how System itself encodes unchanged.
+	systemName System
+)
+
+func NewObject[T ObjectMarshaler](quantity T) Object[T] {
+	return Object[T]{
+		Quantity: quantity,
+	}
+}
+
+func (o Object[T]) MarshalJSON() ([]byte, error) {
+	return o.Quantity.MarshalJSONObject()
+}
+
+func (o *Object[T]) UnmarshalJSON(bytes []byte) error {
+	unmarshaler, ok := interface{}(&o.Quantity).(json.Unmarshaler)
+	if !ok {
+		return fmt.Errorf("could not unmarshal into value of type '%T'", o.Quantity)
+	}
+
+	return unmarshaler.UnmarshalJSON(bytes)
+}
+
+func (s systemName) MarshalText() ([]byte, error) {
+	if name, ok := systemNames[System(s)]; ok {
+		return []byte(name), nil
+	}
+
+	return nil, fmt.Errorf("%d is an invalid system", s)
+}
+
+func (s *systemName) UnmarshalText(text []byte) error {
+	for system, name := range systemNames {
+		if strings.EqualFold(name, string(text)) {
+			*s = systemName(system)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%s is an invalid system", text)
+}
+
+// MarshalObject returns the JSON object form of a quantity holding value in
+// unit.
+func MarshalObject(value float64, unit string, system System) ([]byte, error) {
+	named := systemName(system)
+	return json.Marshal(object{
+		Value:  json.Number(numeric.Format(value)),
+		Unit:   unit,
+		System: &named,
+	})
+}
+
+// UnmarshalWithDefault works like UnmarshalE, but bare numbers and objects
+// without unit are read in defaultUnit. When an object has a system, qualify
+// may rewrite its unit for that system, such as "gal" to "US gal", and the
+// quantity it describes must belong to the system. qualify may be nil.
+func UnmarshalWithDefault[T Measurable](self *T, parse func(input string) (T, error), defaultUnit string, qualify func(unit string, system System) string, bytes []byte) error {
+	trimmed := strings.TrimSpace(string(bytes))
+	if !strings.HasPrefix(trimmed, "{") {
+		if _, err := json.Number(trimmed).Float64(); err == nil {
+			trimmed = fmt.Sprintf("%s %s", trimmed, defaultUnit)
+		}
+		return UnmarshalE(self, parse, []byte(trimmed))
+	}
+
+	var o object
+	if err := json.Unmarshal([]byte(trimmed), &o); err != nil {
+		return err
+	}
+
+	if o.Unit == "" {
+		o.Unit = defaultUnit
+	}
+
+	if o.System != nil && qualify != nil {
+		o.Unit = qualify(o.Unit, System(*o.System))
+	}
+
+	parsed, err := parse(fmt.Sprintf("%s %s", o.Value, o.Unit))
+	if err != nil {
+		return err
+	}
+
+	if quantity, ok := interface{}(parsed).(interface{ System() System }); ok && o.System != nil && quantity.System() != System(*o.System) {
+		name, _ := o.System.MarshalText()
+		return fmt.Errorf("%w: %q is not %s", ErrSystemMismatch, o.Unit, name)
+	}
+
+	*self = parsed
+	return nil
+}
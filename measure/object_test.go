@@ -0,0 +1,378 @@
+package measure
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type fakeObjectMeasurable string
+
+func (f fakeObjectMeasurable) IsZero() bool {
+	return f == ""
+}
+
+func (f fakeObjectMeasurable) MarshalJSONObject() ([]byte, error) {
+	return json.Marshal(map[string]string{"value": string(f)})
+}
+
+func (f *fakeObjectMeasurable) UnmarshalJSON(bytes []byte) error {
+	*f = fakeObjectMeasurable(strings.Trim(string(bytes), `"`))
+	return nil
+}
+
+func Test_systemName_MarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       systemName
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name:    "Should marshal metric",
+			s:       systemName(Metric),
+			want:    []byte("metric"),
+			wantErr: false,
+		},
+		{
+			name:    "Should marshal imperial",
+			s:       systemName(Imperial),
+			want:    []byte("imperial"),
+			wantErr: false,
+		},
+		{
+			name:    "Should marshal US customary",
+			s:       systemName(USCustomary),
+			want:    []byte("us_customary"),
+			wantErr: false,
+		},
+		{
+			name:    "Should return error for an invalid system",
+			s:       systemName(42),
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.s.MarshalText()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarshalText() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalText() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_systemName_UnmarshalText(t *testing.T) {
+	type args struct {
+		text []byte
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    systemName
+		wantErr bool
+	}{
+		{
+			name: "Should unmarshal properly",
+			args: args{
+				text: []byte("us_customary"),
+			},
+			want:    systemName(USCustomary),
+			wantErr: false,
+		},
+		{
+			name: "Should ignore case",
+			args: args{
+				text: []byte("Imperial"),
+			},
+			want:    systemName(Imperial),
+			wantErr: false,
+		},
+		{
+			name: "Should return error for an unknown system",
+			args: args{
+				text: []byte("nautical"),
+			},
+			want:    systemName(Metric),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got systemName
+			if err := got.UnmarshalText(tt.args.text); (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalText() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("UnmarshalText() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMarshalObject(t *testing.T) {
+	type args struct {
+		value  float64
+		unit   string
+		system System
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name: "Should marshal properly",
+			args: args{
+				value:  1.5,
+				unit:   "kg",
+				system: Metric,
+			},
+			want:    []byte(`{"value":1.5,"unit":"kg","system":"metric"}`),
+			wantErr: false,
+		},
+		{
+			name: "Should marshal negative values",
+			args: args{
+				value:  -2,
+				unit:   "US gal",
+				system: USCustomary,
+			},
+			want:    []byte(`{"value":-2,"unit":"US gal","system":"us_customary"}`),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MarshalObject(tt.args.value, tt.args.unit, tt.args.system)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarshalObject() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalObject() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnmarshalWithDefault(t *testing.T) {
+	type args struct {
+		defaultUnit string
+		bytes       []byte
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    fakeStringMeasurable
+		wantErr bool
+	}{
+		{
+			name: "Should unmarshal the string form",
+			args: args{
+				defaultUnit: "foo",
+				bytes:       []byte(`"16 bar"`),
+			},
+			want:    "32.00",
+			wantErr: false,
+		},
+		{
+			name: "Should unmarshal the object form",
+			args: args{
+				defaultUnit: "foo",
+				bytes:       []byte(`{"value":16,"unit":"bar","system":"metric"}`),
+			},
+			want:    "32.00",
+			wantErr: false,
+		},
+		{
+			name: "Should use the default unit for objects without unit",
+			args: args{
+				defaultUnit: "foo",
+				bytes:       []byte(`{"value":16}`),
+			},
+			want:    "16.00",
+			wantErr: false,
+		},
+		{
+			name: "Should use the default unit for bare numbers",
+			args: args{
+				defaultUnit: "bar",
+				bytes:       []byte("16.5"),
+			},
+			want:    "33.00",
+			wantErr: false,
+		},
+		{
+			name: "Should keep the current value if receive null",
+			args: args{
+				defaultUnit: "foo",
+				bytes:       []byte("null"),
+			},
+			want:    "current",
+			wantErr: false,
+		},
+		{
+			name: "Should return error for bare numbers without default unit",
+			args: args{
+				defaultUnit: "",
+				bytes:       []byte("16"),
+			},
+			want:    "current",
+			wantErr: true,
+		},
+		{
+			name: "Should return error for objects with unknown unit",
+			args: args{
+				defaultUnit: "foo",
+				bytes:       []byte(`{"value":16,"unit":"baz"}`),
+			},
+			want:    "current",
+			wantErr: true,
+		},
+		{
+			name: "Should return error for objects without value",
+			args: args{
+				defaultUnit: "foo",
+				bytes:       []byte(`{"unit":"foo"}`),
+			},
+			want:    "current",
+			wantErr: true,
+		},
+		{
+			name: "Should return error for malformed objects",
+			args: args{
+				defaultUnit: "foo",
+				bytes:       []byte(`{"value":"abc"}`),
+			},
+			want:    "current",
+			wantErr: true,
+		},
+	}
+	parsers := ParserMap[fakeStringMeasurable]{
+		"foo": parseFn,
+		"bar": func(value float64) fakeStringMeasurable {
+			return parseFn(value * 2)
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := fakeStringMeasurable("current")
+			if err := UnmarshalWithDefault(&got, parsers.ParseE, tt.args.defaultUnit, nil, tt.args.bytes); (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalWithDefault() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("UnmarshalWithDefault() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnmarshalWithDefault_system(t *testing.T) {
+	type args struct {
+		qualify func(unit string, system System) string
+		bytes   []byte
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    fakeQuantity
+		wantErr error
+	}{
+		{
+			name: "Should accept objects in the system of their unit",
+			args: args{
+				qualify: nil,
+				bytes:   []byte(`{"value":2,"unit":"foo","system":"metric"}`),
+			},
+			want:    fakeQuantity{system: Metric, base: 2},
+			wantErr: nil,
+		},
+		{
+			name: "Should qualify units for the system of the object",
+			args: args{
+				qualify: func(unit string, system System) string {
+					if system == Imperial {
+						return "imp " + unit
+					}
+					return unit
+				},
+				bytes: []byte(`{"value":2,"unit":"foo","system":"imperial"}`),
+			},
+			want:    fakeQuantity{system: Imperial, base: 2},
+			wantErr: nil,
+		},
+		{
+			name: "Should return error if the system contradicts the unit",
+			args: args{
+				qualify: nil,
+				bytes:   []byte(`{"value":2,"unit":"foo","system":"imperial"}`),
+			},
+			want:    fakeQuantity{},
+			wantErr: ErrSystemMismatch,
+		},
+	}
+	parsers := ParserMap[fakeQuantity]{
+		"foo": func(value float64) fakeQuantity {
+			return fakeQuantity{system: Metric, base: value}
+		},
+		"imp foo": func(value float64) fakeQuantity {
+			return fakeQuantity{system: Imperial, base: value}
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := fakeQuantity{}
+			if err := UnmarshalWithDefault(&got, parsers.ParseE, "foo", tt.args.qualify, tt.args.bytes); !errors.Is(err, tt.wantErr) {
+				t.Errorf("UnmarshalWithDefault() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("UnmarshalWithDefault() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestObject(t *testing.T) {
+	type document struct {
+		Weight Object[fakeObjectMeasurable] `json:"weight"`
+	}
+	tests := []struct {
+		name  string
+		doc   document
+		bytes string
+	}{
+		{
+			name:  "Should marshal and unmarshal the object form",
+			doc:   document{Weight: NewObject(fakeObjectMeasurable("16 foo"))},
+			bytes: `{"weight":{"value":"16 foo"}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded, err := json.Marshal(tt.doc)
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+			if string(encoded) != tt.bytes {
+				t.Errorf("json.Marshal() got = %s, want %s", encoded, tt.bytes)
+			}
+
+			var decoded document
+			if err := json.Unmarshal([]byte(`{"weight":"16 foo"}`), &decoded); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v", err)
+			}
+			if !reflect.DeepEqual(decoded, tt.doc) {
+				t.Errorf("json.Unmarshal() got = %v, want %v", decoded, tt.doc)
+			}
+		})
+	}
+}
@@ -0,0 +1,212 @@
+package measure
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+)
+
+type (
+	// Unit is a unit registered at runtime, worth Factor base units.
+	Unit struct {
+		Name   string
+		Factor float64
+		System System
+	}
+
+	// Registry is a concurrency-safe ParserMap that also holds the units
+	// registered at runtime.
+	Registry[T Quantity[T]] struct {
+		mu      sync.RWMutex
+		parsers ParserMap[T]
+		units   map[string]Unit
+	}
+)
+
+func NewRegistry[T Quantity[T]](parsers ParserMap[T]) *Registry[T] {
+	return &Registry[T]{
+		parsers: parsers,
+		units:   map[string]Unit{},
+	}
+}
+
+func (r *Registry[T]) Parse(input string) T {
+	parsed, _ := r.ParseE(input)
+	return parsed
+}
+
+func (r *Registry[T]) ParseE(input string) (T, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.parsers.ParseE(input)
+}
+
+func (r *Registry[T]) ParseLenient(input string) (T, bool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.parsers.ParseLenient(input)
+}
+
+func (r *Registry[T]) ParseMeasurable(input string) (Measurable, error) {
+	return r.ParseE(input)
+}
+
+// Convert returns value in system, or false if value is not a T or is
+// already in system.
+func (r *Registry[T]) Convert(value Measurable, system System) (Measurable, bool) {
+	quantity, ok := value.(T)
+	if !ok {
+		return value, false
+	}
+
+	converted := quantity.FromBase(quantity.Base(), system)
+	if converted.System() == quantity.System() {
+		return value, false
+	}
+
+	return converted, true
+}
+
+func (r *Registry[T]) Units() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.parsers.Units()
+}
+
+// Resolve returns how many base units one unit is worth and its system.
+func (r *Registry[T]) Resolve(unit string) (float64, System, error) {
+	parsed, err := r.ParseE("1 " + unit)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return parsed.Base(), parsed.System(), nil
+}
+
+// RegisterUnit teaches r a new unit worth factor base units. The unit is
+// accepted by its name and by every alias, and values built from it are
+// displayed in system, which T must be able to represent.
+func (r *Registry[T]) RegisterUnit(name string, factor float64, system System, aliases ...string) error {
+	if normalizeUnit(name) == "" {
+		return fmt.Errorf("%w: empty name", ErrInvalidUnit)
+	}
+
+	if factor <= 0 || math.IsInf(factor, 0) || math.IsNaN(factor) {
+		return fmt.Errorf("%w: factor %v of %q must be positive", ErrInvalidUnit, factor, name)
+	}
+
+	var empty T
+	if empty.FromBase(factor, system).System() != system {
+		return fmt.Errorf("%w: %q cannot be in system %d", ErrInvalidUnit, name, system)
+	}
+
+	parser := func(value float64) T {
+		return empty.FromBase(value*factor, system)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	keys := append([]string{name}, aliases...)
+	if err := r.checkAvailable(keys...); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		r.parsers[normalizeUnit(key)] = parser
+	}
+
+	r.units[normalizeUnit(name)] = Unit{
+		Name:   name,
+		Factor: factor,
+		System: system,
+	}
+
+	return nil
+}
+
+// RegisterAlias makes alias parse the same way as the already accepted unit.
+func (r *Registry[T]) RegisterAlias(alias, unit string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	parser, ok := r.parsers[normalizeUnit(unit)]
+	if !ok {
+		return fmt.Errorf("%w %q", ErrUnknownUnit, unit)
+	}
+
+	if err := r.checkAvailable(alias); err != nil {
+		return err
+	}
+
+	r.parsers[normalizeUnit(alias)] = parser
+	return nil
+}
+
+// RegisterNames makes r accept the names and abbreviations of catalog,
+// skipping the ones it already knows.
+func (r *Registry[T]) RegisterNames(catalog NameCatalog) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.parsers.RegisterNames(catalog)
+}
+
+// Names returns the names of the units registered at runtime, sorted.
+func (r *Registry[T]) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, 0, len(r.units))
+	for _, unit := range r.units {
+		names = append(names, unit.Name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// Unit returns the unit registered at runtime under name.
+func (r *Registry[T]) Unit(name string) (Unit, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	unit, ok := r.units[normalizeUnit(name)]
+	return unit, ok
+}
+
+// Best returns the largest registered unit of system that is greater than
+// the unit worth current base units and not greater than base.
+func (r *Registry[T]) Best(system System, base, current float64) (Unit, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var best Unit
+	found := false
+	base = math.Abs(base)
+	for _, unit := range r.units {
+		if unit.System != system || unit.Factor > base || unit.Factor <= current {
+			continue
+		}
+
+		if !found || unit.Factor > best.Factor {
+			best, found = unit, true
+		}
+	}
+
+	return best, found
+}
+
+func (r *Registry[T]) checkAvailable(keys ...string) error {
+	for _, key := range keys {
+		normalized := normalizeUnit(key)
+		if normalized == "" {
+			return fmt.Errorf("%w: empty alias", ErrInvalidUnit)
+		}
+
+		if _, ok := r.parsers[normalized]; ok {
+			return fmt.Errorf("%w %q", ErrUnitAlreadyRegistered, key)
+		}
+	}
+
+	return nil
+}
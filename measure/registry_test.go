@@ -0,0 +1,338 @@
+package measure
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func newFakeRegistry() *Registry[fakeQuantity] {
+	return NewRegistry(ParserMap[fakeQuantity]{
+		"foo": func(value float64) fakeQuantity {
+			return fakeQuantity{system: Metric, base: value}
+		},
+	})
+}
+
+func TestRegistry_RegisterUnit(t *testing.T) {
+	type args struct {
+		name    string
+		factor  float64
+		system  System
+		aliases []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		input   string
+		want    fakeQuantity
+		wantErr error
+	}{
+		{
+			name: "Should parse a registered unit",
+			args: args{
+				name:   "sack",
+				factor: 25,
+				system: Imperial,
+			},
+			input:   "2 sack",
+			want:    fakeQuantity{system: Imperial, base: 50},
+			wantErr: nil,
+		},
+		{
+			name: "Should parse the aliases of a registered unit",
+			args: args{
+				name:    "sack",
+				factor:  25,
+				system:  Metric,
+				aliases: []string{"sacks", "Big Bag"},
+			},
+			input:   "3 big  bag",
+			want:    fakeQuantity{system: Metric, base: 75},
+			wantErr: nil,
+		},
+		{
+			name: "Should return error if the unit is already registered",
+			args: args{
+				name:   "Foo",
+				factor: 25,
+				system: Metric,
+			},
+			wantErr: ErrUnitAlreadyRegistered,
+		},
+		{
+			name: "Should return error if an alias is already registered",
+			args: args{
+				name:    "sack",
+				factor:  25,
+				system:  Metric,
+				aliases: []string{"foo"},
+			},
+			wantErr: ErrUnitAlreadyRegistered,
+		},
+		{
+			name: "Should return error if the name is empty",
+			args: args{
+				name:   " ",
+				factor: 25,
+				system: Metric,
+			},
+			wantErr: ErrInvalidUnit,
+		},
+		{
+			name: "Should return error if the factor is not positive",
+			args: args{
+				name:   "sack",
+				factor: 0,
+				system: Metric,
+			},
+			wantErr: ErrInvalidUnit,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newFakeRegistry()
+			err := r.RegisterUnit(tt.args.name, tt.args.factor, tt.args.system, tt.args.aliases...)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("RegisterUnit() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			got, err := r.ParseE(tt.input)
+			if err != nil {
+				t.Errorf("ParseE() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseE() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegistry_RegisterAlias(t *testing.T) {
+	type args struct {
+		alias string
+		unit  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		input   string
+		want    fakeQuantity
+		wantErr error
+	}{
+		{
+			name: "Should parse the alias as the unit",
+			args: args{
+				alias: "Fl.Foo.",
+				unit:  "FOO",
+			},
+			input:   "2 fl.foo.",
+			want:    fakeQuantity{system: Metric, base: 2},
+			wantErr: nil,
+		},
+		{
+			name: "Should return error if the unit is unknown",
+			args: args{
+				alias: "bar",
+				unit:  "baz",
+			},
+			wantErr: ErrUnknownUnit,
+		},
+		{
+			name: "Should return error if the alias is already registered",
+			args: args{
+				alias: "foo",
+				unit:  "foo",
+			},
+			wantErr: ErrUnitAlreadyRegistered,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newFakeRegistry()
+			err := r.RegisterAlias(tt.args.alias, tt.args.unit)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("RegisterAlias() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			got, err := r.ParseE(tt.input)
+			if err != nil {
+				t.Errorf("ParseE() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseE() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegistry_Unit(t *testing.T) {
+	r := newFakeRegistry()
+	if err := r.RegisterUnit("Sack", 25, Metric, "sacks"); err != nil {
+		t.Fatalf("RegisterUnit() error = %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		input  string
+		want   Unit
+		wantOk bool
+	}{
+		{
+			name:   "Should find a registered unit ignoring case",
+			input:  "sack",
+			want:   Unit{Name: "Sack", Factor: 25, System: Metric},
+			wantOk: true,
+		},
+		{
+			name:   "Should not find aliases",
+			input:  "sacks",
+			want:   Unit{},
+			wantOk: false,
+		},
+		{
+			name:   "Should not find built-in units",
+			input:  "foo",
+			want:   Unit{},
+			wantOk: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := r.Unit(tt.input)
+			if ok != tt.wantOk {
+				t.Errorf("Unit() ok = %v, wantOk %v", ok, tt.wantOk)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Unit() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegistry_Names(t *testing.T) {
+	r := newFakeRegistry()
+	for _, name := range []string{"Sack", "Bag"} {
+		if err := r.RegisterUnit(name, 25, Metric, name+"s"); err != nil {
+			t.Fatalf("RegisterUnit() error = %v", err)
+		}
+	}
+
+	if got, want := r.Names(), []string{"Bag", "Sack"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Names() = %v, want %v", got, want)
+	}
+}
+
+func TestRegistry_Best(t *testing.T) {
+	r := newFakeRegistry()
+	for _, unit := range []Unit{
+		{Name: "sack", Factor: 25, System: Metric},
+		{Name: "pallet", Factor: 1000, System: Metric},
+		{Name: "bushel", Factor: 27, System: Imperial},
+	} {
+		if err := r.RegisterUnit(unit.Name, unit.Factor, unit.System); err != nil {
+			t.Fatalf("RegisterUnit() error = %v", err)
+		}
+	}
+
+	type args struct {
+		system  System
+		base    float64
+		current float64
+	}
+	tests := []struct {
+		name   string
+		args   args
+		want   string
+		wantOk bool
+	}{
+		{
+			name: "Should return the largest unit that fits",
+			args: args{
+				system:  Metric,
+				base:    2000,
+				current: 1,
+			},
+			want:   "pallet",
+			wantOk: true,
+		},
+		{
+			name: "Should consider absolute values",
+			args: args{
+				system:  Metric,
+				base:    -50,
+				current: 1,
+			},
+			want:   "sack",
+			wantOk: true,
+		},
+		{
+			name: "Should ignore units not greater than the current one",
+			args: args{
+				system:  Metric,
+				base:    50,
+				current: 25,
+			},
+			wantOk: false,
+		},
+		{
+			name: "Should ignore units of other systems",
+			args: args{
+				system:  USCustomary,
+				base:    2000,
+				current: 1,
+			},
+			wantOk: false,
+		},
+		{
+			name: "Should ignore units greater than the value",
+			args: args{
+				system:  Imperial,
+				base:    20,
+				current: 1,
+			},
+			wantOk: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := r.Best(tt.args.system, tt.args.base, tt.args.current)
+			if ok != tt.wantOk {
+				t.Errorf("Best() ok = %v, wantOk %v", ok, tt.wantOk)
+			}
+			if got.Name != tt.want {
+				t.Errorf("Best() got = %v, want %v", got.Name, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegistry_concurrency(t *testing.T) {
+	r := newFakeRegistry()
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			_ = r.RegisterUnit(fmt.Sprintf("unit%d", i), float64(i+1), Metric)
+		}(i)
+		go func() {
+			defer wg.Done()
+			_, _ = r.ParseE("1 foo")
+			_ = r.Units()
+		}()
+	}
+	wg.Wait()
+
+	if got := len(r.Units()); got != 51 {
+		t.Errorf("Units() got %d units, want 51", got)
+	}
+}
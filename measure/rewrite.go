@@ -0,0 +1,102 @@
+package measure
+
+import (
+	"fmt"
+	"github.com/alancesar/gogram/numeric"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const significantDigits = 3
+
+var (
+	leadingNumberRegex = regexp.MustCompile(`^[+\-]?\d*\.?\d+`)
+)
+
+type (
+	RewriteOptions struct {
+		// Precision is the number of decimal places of converted values.
+		// When zero, they keep three significant digits instead, so "10 g"
+		// becomes "0.353 oz" rather than "0 oz".
+		Precision int
+		// KeepOriginal appends the original text in parentheses, as in
+		// "18.93 l (5 US gal)".
+		KeepOriginal bool
+	}
+)
+
+// Rewrite returns text with every quantity found by FindAll converted to
+// system. Quantities already in system and the rest of text are kept as is.
+func Rewrite(text string, system System, options RewriteOptions) string {
+	dimensionsMutex.RLock()
+	snapshot := make(map[string]Dimension, len(dimensions))
+	for name, dimension := range dimensions {
+		snapshot[name] = dimension
+	}
+	dimensionsMutex.RUnlock()
+
+	var builder strings.Builder
+	last, original := 0, -1
+	matches := FindAll(text)
+	for i, match := range matches {
+		dimension, ok := snapshot[match.Dimension]
+		if !ok {
+			continue
+		}
+
+		converted, ok := dimension.Convert(match.Value, system)
+		if !ok {
+			continue
+		}
+
+		if original < 0 {
+			original = match.Start
+		}
+
+		builder.WriteString(text[last:match.Start])
+		builder.WriteString(roundLeadingNumber(fmt.Sprint(converted), options.Precision))
+		if !startsRange(text, matches, i) {
+			if options.KeepOriginal {
+				builder.WriteString(" (" + text[original:match.End] + ")")
+			}
+			original = -1
+		}
+		last = match.End
+	}
+
+	builder.WriteString(text[last:])
+	return builder.String()
+}
+
+// startsRange reports whether the i-th match is the first end of a range
+// such as "2-3 cups".
+func startsRange(text string, matches []Match, i int) bool {
+	if i+1 >= len(matches) {
+		return false
+	}
+
+	gap := text[matches[i].End:matches[i+1].Start]
+	return gap != "" && rangeRegex.FindString(gap) == gap
+}
+
+// roundLeadingNumber rounds the number formatted quantities start with to
+// precision decimal places, or to significantDigits when precision is zero.
+func roundLeadingNumber(formatted string, precision int) string {
+	raw := leadingNumberRegex.FindString(formatted)
+	value, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return formatted
+	}
+
+	if precision <= 0 && value != 0 {
+		precision = significantDigits - 1 - int(math.Floor(math.Log10(math.Abs(value))))
+		if precision < 0 {
+			precision = 0
+		}
+	}
+
+	rounded := numeric.Format(numeric.Round(value, precision))
+	return rounded + formatted[len(raw):]
+}
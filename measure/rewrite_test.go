@@ -0,0 +1,63 @@
+package measure
+
+import "testing"
+
+func Test_roundLeadingNumber(t *testing.T) {
+	type args struct {
+		formatted string
+		precision int
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "Should round the leading number",
+			args: args{
+				formatted: "18.927058919999998 l",
+				precision: 2,
+			},
+			want: "18.93 l",
+		},
+		{
+			name: "Should round attached units",
+			args: args{
+				formatted: "-66.66666666666667°C",
+				precision: 1,
+			},
+			want: "-66.7°C",
+		},
+		{
+			name: "Should drop trailing zeros",
+			args: args{
+				formatted: "2.0001 kg",
+				precision: 2,
+			},
+			want: "2 kg",
+		},
+		{
+			name: "Should keep three significant digits without precision",
+			args: args{
+				formatted: "0.3527399072294044 oz",
+				precision: 0,
+			},
+			want: "0.353 oz",
+		},
+		{
+			name: "Should keep text without leading number",
+			args: args{
+				formatted: "abc",
+				precision: 2,
+			},
+			want: "abc",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := roundLeadingNumber(tt.args.formatted, tt.args.precision); got != tt.want {
+				t.Errorf("roundLeadingNumber() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package measure
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+)
+
+type (
+	// Canonical stores a quantity in a numeric column holding its value in
+	// the base unit of its dimension (grams, liters, degrees Celsius), so
+	// it can be indexed and queried by range. Scanned values are tracked
+	// in the metric system.
+	Canonical[T Quantity[T]] struct {
+		Quantity T
+	}
+)
+
+// IsZero reports whether the quantity is zero, so c can be wrapped in Null
+// to tell NULL columns apart from zero values.
+func (c Canonical[T]) IsZero() bool {
+	return c.Quantity.IsZero()
+}
+
+func (c Canonical[T]) Value() (driver.Value, error) {
+	return c.Quantity.Base(), nil
+}
+
+func (c *Canonical[T]) Scan(src interface{}) error {
+	var empty T
+
+	switch v := src.(type) {
+	case nil:
+		c.Quantity = empty
+	case float64:
+		c.Quantity = empty.FromBase(v, Metric)
+	case int64:
+		c.Quantity = empty.FromBase(float64(v), Metric)
+	case []byte:
+		return c.Scan(string(v))
+	case string:
+		value, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return fmt.Errorf("could not scan value '%s' as a number: %w", v, err)
+		}
+		c.Quantity = empty.FromBase(value, Metric)
+	default:
+		return fmt.Errorf("could not scan value '%+v' of type '%T'", src, src)
+	}
+
+	return nil
+}
+
+// Value returns input as text, to be stored in a string column.
+func Value(input fmt.Stringer) (driver.Value, error) {
+	return input.String(), nil
+}
+
+// Scan parses src from a string column into self, setting it to the zero
+// value if src is NULL.
+func Scan[T Measurable](self *T, parse func(input string) (T, error), src interface{}) error {
+	var raw string
+
+	switch v := src.(type) {
+	case nil:
+		var empty T
+		*self = empty
+		return nil
+	case string:
+		raw = v
+	case []byte:
+		raw = string(v)
+	default:
+		return fmt.Errorf("could not scan value '%+v' of type '%T'", src, src)
+	}
+
+	parsed, err := parse(raw)
+	if err != nil {
+		return err
+	}
+
+	*self = parsed
+	return nil
+}
@@ -0,0 +1,239 @@
+package measure
+
+import (
+	"database/sql/driver"
+	"reflect"
+	"testing"
+)
+
+func TestCanonical_Value(t *testing.T) {
+	tests := []struct {
+		name      string
+		canonical Canonical[fakeQuantity]
+		want      driver.Value
+		wantErr   bool
+	}{
+		{
+			name:      "Should return the base value",
+			canonical: Canonical[fakeQuantity]{fakeQuantity{Imperial, 453.592}},
+			want:      453.592,
+			wantErr:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.canonical.Value()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Value() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Value() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCanonical_Scan(t *testing.T) {
+	type args struct {
+		src interface{}
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Canonical[fakeQuantity]
+		wantErr bool
+	}{
+		{
+			name: "Should scan from float64",
+			args: args{
+				src: 1.5,
+			},
+			want:    Canonical[fakeQuantity]{fakeQuantity{Metric, 1.5}},
+			wantErr: false,
+		},
+		{
+			name: "Should scan from int64",
+			args: args{
+				src: int64(2),
+			},
+			want:    Canonical[fakeQuantity]{fakeQuantity{Metric, 2}},
+			wantErr: false,
+		},
+		{
+			name: "Should scan from bytes",
+			args: args{
+				src: []byte("2.5"),
+			},
+			want:    Canonical[fakeQuantity]{fakeQuantity{Metric, 2.5}},
+			wantErr: false,
+		},
+		{
+			name: "Should scan NULL as zero value",
+			args: args{
+				src: nil,
+			},
+			want:    Canonical[fakeQuantity]{},
+			wantErr: false,
+		},
+		{
+			name: "Should return error for non numeric strings",
+			args: args{
+				src: "abc",
+			},
+			want:    Canonical[fakeQuantity]{fakeQuantity{Metric, 9}},
+			wantErr: true,
+		},
+		{
+			name: "Should return error for unsupported types",
+			args: args{
+				src: true,
+			},
+			want:    Canonical[fakeQuantity]{fakeQuantity{Metric, 9}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Canonical[fakeQuantity]{fakeQuantity{Metric, 9}}
+			if err := got.Scan(tt.args.src); (err != nil) != tt.wantErr {
+				t.Errorf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Scan() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNull_ScanCanonical(t *testing.T) {
+	type args struct {
+		src interface{}
+	}
+	tests := []struct {
+		name string
+		args args
+		want Null[Canonical[fakeQuantity]]
+	}{
+		{
+			name: "Should scan NULL as invalid",
+			args: args{
+				src: nil,
+			},
+			want: Null[Canonical[fakeQuantity]]{},
+		},
+		{
+			name: "Should scan zero as a valid zero quantity",
+			args: args{
+				src: 0.0,
+			},
+			want: NewNull(Canonical[fakeQuantity]{fakeQuantity{Metric, 0}}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Null[Canonical[fakeQuantity]]
+			if err := got.Scan(tt.args.src); err != nil {
+				t.Errorf("Scan() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Scan() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   fakeStringMeasurable
+		want    driver.Value
+		wantErr bool
+	}{
+		{
+			name:    "Should return the string representation",
+			input:   fakeStringMeasurable("abc"),
+			want:    "abc implements Stringer",
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Value(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Value() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Value() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScan(t *testing.T) {
+	type args struct {
+		src interface{}
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    fakeStringMeasurable
+		wantErr bool
+	}{
+		{
+			name: "Should scan from string",
+			args: args{
+				src: "16 foo",
+			},
+			want:    "16.00",
+			wantErr: false,
+		},
+		{
+			name: "Should scan from bytes",
+			args: args{
+				src: []byte("16 foo"),
+			},
+			want:    "16.00",
+			wantErr: false,
+		},
+		{
+			name: "Should scan NULL as zero value",
+			args: args{
+				src: nil,
+			},
+			want:    "",
+			wantErr: false,
+		},
+		{
+			name: "Should return error for unknown unit",
+			args: args{
+				src: "16 bar",
+			},
+			want:    "current",
+			wantErr: true,
+		},
+		{
+			name: "Should return error for unsupported types",
+			args: args{
+				src: 16.0,
+			},
+			want:    "current",
+			wantErr: true,
+		},
+	}
+	parsers := ParserMap[fakeStringMeasurable]{
+		"foo": parseFn,
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := fakeStringMeasurable("current")
+			if err := Scan(&got, parsers.ParseE, tt.args.src); (err != nil) != tt.wantErr {
+				t.Errorf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Scan() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package measure
+
+import (
+	"encoding/xml"
+	"fmt"
+)
+
+// MarshalText returns the string form of input, such as "1.5 kg", for
+// text-based encoders (YAML, TOML, environment variables, XML).
+func MarshalText(input fmt.Stringer) ([]byte, error) {
+	return []byte(input.String()), nil
+}
+
+func UnmarshalText[T Measurable](self *T, parse func(input string) (T, error), text []byte) error {
+	parsed, err := parse(string(text))
+	if err != nil {
+		return err
+	}
+
+	*self = parsed
+	return nil
+}
+
+func MarshalXMLAttr(input fmt.Stringer, name xml.Name) (xml.Attr, error) {
+	return xml.Attr{
+		Name:  name,
+		Value: input.String(),
+	}, nil
+}
+
+func UnmarshalXMLAttr[T Measurable](self *T, parse func(input string) (T, error), attr xml.Attr) error {
+	return UnmarshalText(self, parse, []byte(attr.Value))
+}
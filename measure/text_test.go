@@ -0,0 +1,167 @@
+package measure
+
+import (
+	"encoding/xml"
+	"reflect"
+	"testing"
+)
+
+func TestMarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   fakeStringMeasurable
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name:    "Should marshal without quotes",
+			input:   "abc",
+			want:    []byte("abc implements Stringer"),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MarshalText(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarshalText() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalText() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnmarshalText(t *testing.T) {
+	type args struct {
+		text []byte
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    fakeStringMeasurable
+		wantErr bool
+	}{
+		{
+			name: "Should unmarshal properly",
+			args: args{
+				text: []byte("16 foo"),
+			},
+			want:    "16.00",
+			wantErr: false,
+		},
+		{
+			name: "Should return error if receive an empty text",
+			args: args{
+				text: []byte(""),
+			},
+			want:    "current",
+			wantErr: true,
+		},
+		{
+			name: "Should return error if receive an unknown unit",
+			args: args{
+				text: []byte("16 bar"),
+			},
+			want:    "current",
+			wantErr: true,
+		},
+	}
+	parsers := ParserMap[fakeStringMeasurable]{
+		"foo": parseFn,
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := fakeStringMeasurable("current")
+			if err := UnmarshalText(&got, parsers.ParseE, tt.args.text); (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalText() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("UnmarshalText() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMarshalXMLAttr(t *testing.T) {
+	type args struct {
+		input fakeStringMeasurable
+		name  xml.Name
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    xml.Attr
+		wantErr bool
+	}{
+		{
+			name: "Should marshal properly",
+			args: args{
+				input: "abc",
+				name:  xml.Name{Local: "weight"},
+			},
+			want: xml.Attr{
+				Name:  xml.Name{Local: "weight"},
+				Value: "abc implements Stringer",
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MarshalXMLAttr(tt.args.input, tt.args.name)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarshalXMLAttr() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalXMLAttr() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnmarshalXMLAttr(t *testing.T) {
+	type args struct {
+		attr xml.Attr
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    fakeStringMeasurable
+		wantErr bool
+	}{
+		{
+			name: "Should unmarshal properly",
+			args: args{
+				attr: xml.Attr{Name: xml.Name{Local: "weight"}, Value: "16 foo"},
+			},
+			want:    "16.00",
+			wantErr: false,
+		},
+		{
+			name: "Should return error if receive an unknown unit",
+			args: args{
+				attr: xml.Attr{Name: xml.Name{Local: "weight"}, Value: "16 bar"},
+			},
+			want:    "current",
+			wantErr: true,
+		},
+	}
+	parsers := ParserMap[fakeStringMeasurable]{
+		"foo": parseFn,
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := fakeStringMeasurable("current")
+			if err := UnmarshalXMLAttr(&got, parsers.ParseE, tt.args.attr); (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalXMLAttr() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("UnmarshalXMLAttr() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
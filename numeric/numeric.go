@@ -1,6 +1,7 @@
 package numeric
 
 import (
+	"fmt"
 	"math"
 	"strconv"
 )
@@ -9,6 +10,7 @@ const (
 	formatter        = 'f'
 	defaultPrecision = -1
 	bitSize          = 64
+	epsilon          = 1e-9
 )
 
 func Format(precision float64) string {
@@ -24,3 +26,64 @@ func Round(input float64, decimal int) float64 {
 	rounded := math.Round(input * factor)
 	return float64(int(rounded)) / factor
 }
+
+// Compare returns -1, 0 or +1 depending on whether a is less than, equal to
+// or greater than b. Values within a relative epsilon of each other are
+// considered equal, which absorbs the rounding of unit conversions.
+func Compare(a, b float64) int {
+	switch {
+	case math.Abs(a-b) <= epsilon*math.Max(math.Abs(a), math.Abs(b)):
+		return 0
+	case a < b:
+		return -1
+	default:
+		return 1
+	}
+}
+
+// WithinPercent reports whether a and b differ by at most percent of the
+// larger of their magnitudes.
+func WithinPercent(a, b, percent float64) bool {
+	return math.Abs(a-b) <= math.Max(math.Abs(a), math.Abs(b))*percent/100
+}
+
+// FormatFraction formats value as a whole number and the closest fraction
+// whose denominator is at most maxDenominator, such as "1 1/2" or "3/4".
+func FormatFraction(value float64, maxDenominator int) string {
+	if maxDenominator < 1 {
+		maxDenominator = 1
+	}
+
+	sign := ""
+	if value < 0 {
+		sign = "-"
+		value = -value
+	}
+
+	whole := math.Floor(value)
+	remainder := value - whole
+
+	numerator, denominator := 0, 1
+	bestError := remainder
+	for d := 1; d <= maxDenominator; d++ {
+		n := int(math.Round(remainder * float64(d)))
+		if e := math.Abs(remainder - float64(n)/float64(d)); e < bestError-epsilon {
+			numerator, denominator, bestError = n, d, e
+		}
+	}
+
+	if numerator == denominator {
+		whole, numerator = whole+1, 0
+	}
+
+	switch {
+	case numerator == 0 && whole == 0:
+		return "0"
+	case numerator == 0:
+		return sign + Format(whole)
+	case whole == 0:
+		return fmt.Sprintf("%s%d/%d", sign, numerator, denominator)
+	default:
+		return fmt.Sprintf("%s%s %d/%d", sign, Format(whole), numerator, denominator)
+	}
+}
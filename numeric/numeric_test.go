@@ -99,3 +99,186 @@ func TestRound(t *testing.T) {
 		})
 	}
 }
+
+func TestCompare(t *testing.T) {
+	type args struct {
+		a float64
+		b float64
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		{
+			name: "Should return -1 if a is less than b",
+			args: args{
+				a: 1,
+				b: 2,
+			},
+			want: -1,
+		},
+		{
+			name: "Should return 1 if a is greater than b",
+			args: args{
+				a: 2,
+				b: 1,
+			},
+			want: 1,
+		},
+		{
+			name: "Should return 0 if a equals b",
+			args: args{
+				a: 2,
+				b: 2,
+			},
+			want: 0,
+		},
+		{
+			name: "Should return 0 if a and b differ by a rounding error",
+			args: args{
+				a: 0.1 + 0.2,
+				b: 0.3,
+			},
+			want: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Compare(tt.args.a, tt.args.b); got != tt.want {
+				t.Errorf("Compare() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithinPercent(t *testing.T) {
+	type args struct {
+		a       float64
+		b       float64
+		percent float64
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "Should return true if within percent",
+			args: args{
+				a:       100,
+				b:       99,
+				percent: 1,
+			},
+			want: true,
+		},
+		{
+			name: "Should return false if out of percent",
+			args: args{
+				a:       100,
+				b:       98,
+				percent: 1,
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := WithinPercent(tt.args.a, tt.args.b, tt.args.percent); got != tt.want {
+				t.Errorf("WithinPercent() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatFraction(t *testing.T) {
+	type args struct {
+		value          float64
+		maxDenominator int
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "Should format whole numbers",
+			args: args{
+				value:          2,
+				maxDenominator: 8,
+			},
+			want: "2",
+		},
+		{
+			name: "Should format proper fractions",
+			args: args{
+				value:          0.75,
+				maxDenominator: 8,
+			},
+			want: "3/4",
+		},
+		{
+			name: "Should format mixed numbers",
+			args: args{
+				value:          1.5,
+				maxDenominator: 8,
+			},
+			want: "1 1/2",
+		},
+		{
+			name: "Should approximate to the closest fraction",
+			args: args{
+				value:          0.3333,
+				maxDenominator: 4,
+			},
+			want: "1/3",
+		},
+		{
+			name: "Should reduce fractions",
+			args: args{
+				value:          0.25,
+				maxDenominator: 16,
+			},
+			want: "1/4",
+		},
+		{
+			name: "Should carry when rounding up",
+			args: args{
+				value:          1.99,
+				maxDenominator: 4,
+			},
+			want: "2",
+		},
+		{
+			name: "Should format negative values",
+			args: args{
+				value:          -1.5,
+				maxDenominator: 2,
+			},
+			want: "-1 1/2",
+		},
+		{
+			name: "Should format zero",
+			args: args{
+				value:          0.01,
+				maxDenominator: 4,
+			},
+			want: "0",
+		},
+		{
+			name: "Should treat denominators below one as one",
+			args: args{
+				value:          1.4,
+				maxDenominator: 0,
+			},
+			want: "1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatFraction(tt.args.value, tt.args.maxDenominator); got != tt.want {
+				t.Errorf("FormatFraction() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,250 @@
+package temperature
+
+import (
+	"encoding/xml"
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/alancesar/gogram/measure"
+	"github.com/alancesar/gogram/numeric"
+	"strings"
+)
+
+var (
+	deltaParsers = measure.ParserMap[Delta]{
+		"c":          NewDeltaFromCelsius,
+		"ºc":         NewDeltaFromCelsius,
+		"°c":         NewDeltaFromCelsius,
+		"celsius":    NewDeltaFromCelsius,
+		"centigrade": NewDeltaFromCelsius,
+		"f":          NewDeltaFromFahrenheit,
+		"ºf":         NewDeltaFromFahrenheit,
+		"°f":         NewDeltaFromFahrenheit,
+		"fahrenheit": NewDeltaFromFahrenheit,
+		"k":          NewDeltaFromKelvin,
+		"kelvin":     NewDeltaFromKelvin,
+		"kelvins":    NewDeltaFromKelvin,
+		"°r":         NewDeltaFromRankine,
+		"ºr":         NewDeltaFromRankine,
+		"ra":         NewDeltaFromRankine,
+		"rankine":    NewDeltaFromRankine,
+	}
+
+	deltaPrefixes = []string{"Δ", "∆"}
+)
+
+type (
+	// Delta is a difference between two temperatures. Unlike Temperature
+	// it converts between units by scale only, so a delta of 1 °C is a
+	// delta of 1.8 °F and of 1 K.
+	Delta struct {
+		unit                Unit
+		celsius, fahrenheit float64
+	}
+)
+
+func NewDeltaFromString(input string) Delta {
+	d, _ := ParseDelta(input)
+	return d
+}
+
+// ParseDelta parses inputs such as "5°F", "+5 °F" or "Δ3 K".
+func ParseDelta(input string) (Delta, error) {
+	trimmed := strings.TrimSpace(input)
+	for _, prefix := range deltaPrefixes {
+		if !strings.HasPrefix(trimmed, prefix) {
+			continue
+		}
+
+		offset := strings.Index(input, prefix) + len(prefix)
+		d, err := deltaParsers.ParseE(input[offset:])
+		var parseErr *measure.ParseError
+		if errors.As(err, &parseErr) {
+			parseErr.Input = input
+			parseErr.Position += offset
+		}
+
+		return d, err
+	}
+
+	return deltaParsers.ParseE(input)
+}
+
+// ParseDeltaLocalized parses input written in locale, such as "2,5 °C" in German.
+func ParseDeltaLocalized(input string, locale measure.Locale) (Delta, error) {
+	return measure.ParseLocalized(ParseDelta, input, locale)
+}
+
+// DeltaVar defines a temperature delta flag with the specified name, default value and
+// usage string on flag.CommandLine, storing its value in p.
+func DeltaVar(p *Delta, name string, value Delta, usage string) {
+	*p = value
+	flag.Var(p, name, usage)
+}
+
+func NewDeltaFromCelsius(value float64) Delta {
+	return Delta{
+		unit:       Celsius,
+		celsius:    value,
+		fahrenheit: value * 1.8,
+	}
+}
+
+func NewDeltaFromFahrenheit(value float64) Delta {
+	return Delta{
+		unit:       Fahrenheit,
+		celsius:    value / 1.8,
+		fahrenheit: value,
+	}
+}
+
+func NewDeltaFromKelvin(value float64) Delta {
+	return Delta{
+		unit:       Kelvin,
+		celsius:    value,
+		fahrenheit: value * 1.8,
+	}
+}
+
+func NewDeltaFromRankine(value float64) Delta {
+	return Delta{
+		unit:       Rankine,
+		celsius:    value / 1.8,
+		fahrenheit: value,
+	}
+}
+
+func (d Delta) IsZero() bool {
+	return d.celsius == 0 && d.fahrenheit == 0
+}
+
+func (d Delta) Celsius() float64 {
+	return d.celsius
+}
+
+func (d Delta) Fahrenheit() float64 {
+	return d.fahrenheit
+}
+
+func (d Delta) Kelvin() float64 {
+	return d.celsius
+}
+
+func (d Delta) Rankine() float64 {
+	return d.fahrenheit
+}
+
+func (d Delta) String() string {
+	unit := d.findBestUnit()
+	return d.StringIn(unit)
+}
+
+func (d Delta) StringIn(unit Unit) string {
+	value, err := d.Float64In(unit)
+	if err != nil {
+		return ""
+	}
+	formatted := numeric.Format(value)
+	return fmt.Sprintf("%s%s", formatted, unit)
+}
+
+// StringLocalized works like String, but with the separators and unit
+// spacing of locale.
+func (d Delta) StringLocalized(locale measure.Locale) string {
+	unit := d.findBestUnit()
+	value, err := d.Float64In(unit)
+	if err != nil {
+		return ""
+	}
+	return locale.Format(value, names.Abbreviation(string(unit), locale))
+}
+
+// StringLong works like StringLocalized, but with the long name of the unit,
+// such as "1 grau Celsius".
+func (d Delta) StringLong(locale measure.Locale) string {
+	unit := d.findBestUnit()
+	value, err := d.Float64In(unit)
+	if err != nil {
+		return ""
+	}
+	return names.FormatLong(value, "temperature", string(unit), locale)
+}
+
+func (d Delta) Float64In(unit Unit) (float64, error) {
+	switch unit {
+	case Celsius:
+		return d.Celsius(), nil
+	case Fahrenheit:
+		return d.Fahrenheit(), nil
+	case Kelvin:
+		return d.Kelvin(), nil
+	case Rankine:
+		return d.Rankine(), nil
+	default:
+		return 0, fmt.Errorf("%s is an invalid unit for temperature delta", unit)
+	}
+}
+
+func (d Delta) MarshalJSON() ([]byte, error) {
+	return measure.Marshal(d)
+}
+
+// MarshalJSONObject marshals d to a JSON object such as
+// {"value":5,"unit":"°F","system":"imperial"}. measure.Object uses it.
+func (d Delta) MarshalJSONObject() ([]byte, error) {
+	unit := d.findBestUnit()
+	value, _ := d.Float64In(unit)
+	return measure.MarshalObject(value, string(unit), systemOf(unit))
+}
+
+func (d *Delta) UnmarshalJSON(bytes []byte) error {
+	return measure.UnmarshalWithDefault(d, ParseDelta, string(DefaultUnit), nil, bytes)
+}
+
+func (d Delta) MarshalText() ([]byte, error) {
+	return measure.MarshalText(d)
+}
+
+func (d *Delta) UnmarshalText(text []byte) error {
+	return measure.UnmarshalText(d, ParseDelta, text)
+}
+
+func (d Delta) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return measure.MarshalXMLAttr(d, name)
+}
+
+func (d *Delta) UnmarshalXMLAttr(attr xml.Attr) error {
+	return measure.UnmarshalXMLAttr(d, ParseDelta, attr)
+}
+
+// Set implements flag.Value, so *Delta can be given to flag.Var.
+func (d *Delta) Set(value string) error {
+	return measure.Set(d, ParseDelta, symbols(), value)
+}
+
+// Get implements flag.Getter.
+func (d Delta) Get() interface{} {
+	return d
+}
+
+func (d Delta) findBestUnit() Unit {
+	switch d.unit {
+	case Celsius, Kelvin, Rankine:
+		return d.unit
+	default:
+		return Fahrenheit
+	}
+}
+
+func newDeltaFromUnit(unit Unit, value float64) Delta {
+	switch unit {
+	case Celsius:
+		return NewDeltaFromCelsius(value)
+	case Kelvin:
+		return NewDeltaFromKelvin(value)
+	case Rankine:
+		return NewDeltaFromRankine(value)
+	default:
+		return NewDeltaFromFahrenheit(value)
+	}
+}
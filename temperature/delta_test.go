@@ -0,0 +1,608 @@
+package temperature
+
+import (
+	"encoding/xml"
+	"errors"
+	"flag"
+	"github.com/alancesar/gogram/measure"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNewDeltaFromCelsius(t *testing.T) {
+	type args struct {
+		value float64
+	}
+	tests := []struct {
+		name string
+		args args
+		want Delta
+	}{
+		{
+			name: "Should parse from Celsius",
+			args: args{
+				value: 10,
+			},
+			want: Delta{
+				unit:       Celsius,
+				celsius:    10,
+				fahrenheit: 18,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewDeltaFromCelsius(tt.args.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewDeltaFromCelsius() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewDeltaFromFahrenheit(t *testing.T) {
+	type args struct {
+		value float64
+	}
+	tests := []struct {
+		name string
+		args args
+		want Delta
+	}{
+		{
+			name: "Should parse from Fahrenheit",
+			args: args{
+				value: 9,
+			},
+			want: Delta{
+				unit:       Fahrenheit,
+				celsius:    5,
+				fahrenheit: 9,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewDeltaFromFahrenheit(tt.args.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewDeltaFromFahrenheit() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewDeltaFromKelvin(t *testing.T) {
+	type args struct {
+		value float64
+	}
+	tests := []struct {
+		name string
+		args args
+		want Delta
+	}{
+		{
+			name: "Should parse from Kelvin",
+			args: args{
+				value: 10,
+			},
+			want: Delta{
+				unit:       Kelvin,
+				celsius:    10,
+				fahrenheit: 18,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewDeltaFromKelvin(tt.args.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewDeltaFromKelvin() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewDeltaFromRankine(t *testing.T) {
+	type args struct {
+		value float64
+	}
+	tests := []struct {
+		name string
+		args args
+		want Delta
+	}{
+		{
+			name: "Should parse from Rankine",
+			args: args{
+				value: 9,
+			},
+			want: Delta{
+				unit:       Rankine,
+				celsius:    5,
+				fahrenheit: 9,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewDeltaFromRankine(tt.args.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewDeltaFromRankine() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDelta(t *testing.T) {
+	type args struct {
+		input string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Delta
+		wantErr error
+	}{
+		{
+			name: "Should parse from '5°F' string",
+			args: args{
+				input: "5°F",
+			},
+			want:    NewDeltaFromFahrenheit(5),
+			wantErr: nil,
+		},
+		{
+			name: "Should parse from '+5 °F' string",
+			args: args{
+				input: "+5 °F",
+			},
+			want:    NewDeltaFromFahrenheit(5),
+			wantErr: nil,
+		},
+		{
+			name: "Should parse from '-2C' string",
+			args: args{
+				input: "-2C",
+			},
+			want:    NewDeltaFromCelsius(-2),
+			wantErr: nil,
+		},
+		{
+			name: "Should parse from 'Δ3 K' string",
+			args: args{
+				input: "Δ3 K",
+			},
+			want:    NewDeltaFromKelvin(3),
+			wantErr: nil,
+		},
+		{
+			name: "Should parse below absolute zero values",
+			args: args{
+				input: "-500K",
+			},
+			want:    NewDeltaFromKelvin(-500),
+			wantErr: nil,
+		},
+		{
+			name: "Should return error for unknown unit",
+			args: args{
+				input: "Δ3 xx",
+			},
+			want:    Delta{},
+			wantErr: measure.ErrUnknownUnit,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDelta(tt.args.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ParseDelta() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseDelta() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDelta_errorPosition(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  *measure.ParseError
+	}{
+		{
+			name:  "Should report the position within the original input",
+			input: " Δ3 xx",
+			want: &measure.ParseError{
+				Err:      measure.ErrUnknownUnit,
+				Input:    " Δ3 xx",
+				Token:    "xx",
+				Position: 5,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseDelta(tt.input)
+			if !reflect.DeepEqual(err, tt.want) {
+				t.Errorf("ParseDelta() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestDelta_String(t *testing.T) {
+	tests := []struct {
+		name  string
+		delta Delta
+		want  string
+	}{
+		{
+			name:  "Should print 5°C",
+			delta: NewDeltaFromCelsius(5),
+			want:  "5°C",
+		},
+		{
+			name:  "Should print -9°F",
+			delta: NewDeltaFromFahrenheit(-9),
+			want:  "-9°F",
+		},
+		{
+			name:  "Should print 3K",
+			delta: NewDeltaFromKelvin(3),
+			want:  "3K",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.delta.String(); got != tt.want {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDelta_Float64In(t *testing.T) {
+	type args struct {
+		unit Unit
+	}
+	tests := []struct {
+		name    string
+		delta   Delta
+		args    args
+		want    float64
+		wantErr bool
+	}{
+		{
+			name:  "Should get 18°F from 10°C",
+			delta: NewDeltaFromCelsius(10),
+			args: args{
+				unit: Fahrenheit,
+			},
+			want:    18,
+			wantErr: false,
+		},
+		{
+			name:  "Should get 10K from 10°C",
+			delta: NewDeltaFromCelsius(10),
+			args: args{
+				unit: Kelvin,
+			},
+			want:    10,
+			wantErr: false,
+		},
+		{
+			name:  "Should get 9°R from 9°F",
+			delta: NewDeltaFromFahrenheit(9),
+			args: args{
+				unit: Rankine,
+			},
+			want:    9,
+			wantErr: false,
+		},
+		{
+			name:  "Should return error for invalid unit",
+			delta: NewDeltaFromCelsius(10),
+			args: args{
+				unit: "Invalid",
+			},
+			want:    0,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.delta.Float64In(tt.args.unit)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Float64In() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Float64In() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDelta_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		delta   Delta
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name:    "Should marshal properly",
+			delta:   NewDeltaFromFahrenheit(5),
+			want:    []byte(`"5°F"`),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.delta.MarshalJSON()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalJSON() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDelta_UnmarshalJSON(t *testing.T) {
+	type args struct {
+		bytes []byte
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Delta
+		wantErr bool
+	}{
+		{
+			name: "Should unmarshal properly",
+			args: args{
+				bytes: []byte(`"Δ3 K"`),
+			},
+			want:    NewDeltaFromKelvin(3),
+			wantErr: false,
+		},
+		{
+			name: "Should unmarshal the object form",
+			args: args{
+				bytes: []byte(`{"value":9,"unit":"°F","system":"imperial"}`),
+			},
+			want:    NewDeltaFromFahrenheit(9),
+			wantErr: false,
+		},
+		{
+			name: "Should unmarshal bare numbers in the default unit",
+			args: args{
+				bytes: []byte("3"),
+			},
+			want:    NewDeltaFromCelsius(3),
+			wantErr: false,
+		},
+		{
+			name: "Should return error for unknown unit",
+			args: args{
+				bytes: []byte(`"3 xx"`),
+			},
+			want:    Delta{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &Delta{}
+			if err := d.UnmarshalJSON(tt.args.bytes); (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !reflect.DeepEqual(*d, tt.want) {
+				t.Errorf("UnmarshalJSON() got = %v, want %v", d, tt.want)
+			}
+		})
+	}
+}
+
+func TestDelta_MarshalJSONObject(t *testing.T) {
+	tests := []struct {
+		name     string
+		quantity Delta
+		want     []byte
+		wantErr  bool
+	}{
+		{
+			name:     "Should marshal NewDeltaFromKelvin(3) as object",
+			quantity: NewDeltaFromKelvin(3),
+			want:     []byte(`{"value":3,"unit":"K","system":"metric"}`),
+			wantErr:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.quantity.MarshalJSONObject()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarshalJSONObject() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalJSONObject() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDelta_xml(t *testing.T) {
+	type document struct {
+		XMLName   xml.Name `xml:"document"`
+		Attribute Delta    `xml:"rise,attr"`
+		Element   Delta    `xml:"rise"`
+	}
+	tests := []struct {
+		name string
+		doc  document
+		want string
+	}{
+		{
+			name: "Should encode and decode attributes and elements",
+			doc: document{
+				Attribute: NewDeltaFromFahrenheit(5),
+				Element:   NewDeltaFromFahrenheit(5),
+			},
+			want: `<document rise="5°F"><rise>5°F</rise></document>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded, err := xml.Marshal(tt.doc)
+			if err != nil {
+				t.Fatalf("xml.Marshal() error = %v", err)
+			}
+			if string(encoded) != tt.want {
+				t.Errorf("xml.Marshal() got = %s, want %s", encoded, tt.want)
+			}
+
+			var decoded document
+			if err := xml.Unmarshal(encoded, &decoded); err != nil {
+				t.Fatalf("xml.Unmarshal() error = %v", err)
+			}
+			if !reflect.DeepEqual(decoded.Attribute, tt.doc.Attribute) || !reflect.DeepEqual(decoded.Element, tt.doc.Element) {
+				t.Errorf("xml.Unmarshal() got = %v, want %v", decoded, tt.doc)
+			}
+		})
+	}
+}
+
+func TestDeltaVar(t *testing.T) {
+	defer func(commandLine *flag.FlagSet) {
+		flag.CommandLine = commandLine
+	}(flag.CommandLine)
+	flag.CommandLine = flag.NewFlagSet("test", flag.ContinueOnError)
+
+	var got Delta
+	DeltaVar(&got, "value", NewDeltaFromCelsius(1), "usage")
+	if err := flag.Set("value", "Δ5 °F"); err != nil {
+		t.Errorf("flag.Set() error = %v", err)
+	}
+	if want := NewDeltaFromFahrenheit(5); !reflect.DeepEqual(flag.Lookup("value").Value.(flag.Getter).Get(), want) {
+		t.Errorf("DeltaVar() got = %v, want %v", got, want)
+	}
+
+	wantMessage := "accepted units: °C, °F, K, °R"
+	if err := flag.Set("value", "5 xx"); err == nil || !strings.HasSuffix(err.Error(), wantMessage) {
+		t.Errorf("flag.Set() error = %v, want message %v", err, wantMessage)
+	}
+}
+
+func TestParseDeltaLocalized(t *testing.T) {
+	type args struct {
+		input  string
+		locale measure.Locale
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Delta
+		wantErr bool
+	}{
+		{
+			name: "Should parse Brazilian decimals",
+			args: args{
+				input:  "2,5 °C",
+				locale: measure.BrazilianPortuguese,
+			},
+			want:    NewDeltaFromCelsius(2.5),
+			wantErr: false,
+		},
+		{
+			name: "Should parse localized names",
+			args: args{
+				input:  "2,5 graus Celsius",
+				locale: measure.BrazilianPortuguese,
+			},
+			want:    NewDeltaFromCelsius(2.5),
+			wantErr: false,
+		},
+		{
+			name: "Should return error for unknown units",
+			args: args{
+				input:  "2,5 foo",
+				locale: measure.German,
+			},
+			want:    Delta{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDeltaLocalized(tt.args.input, tt.args.locale)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseDeltaLocalized() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseDeltaLocalized() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDelta_StringLocalized(t *testing.T) {
+	type args struct {
+		locale measure.Locale
+	}
+	tests := []struct {
+		name string
+		d    Delta
+		args args
+		want string
+	}{
+		{
+			name: "Should format in Brazilian Portuguese",
+			d:    NewDeltaFromCelsius(2.5),
+			args: args{
+				locale: measure.BrazilianPortuguese,
+			},
+			want: "2,5 °C",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.d.StringLocalized(tt.args.locale); got != tt.want {
+				t.Errorf("StringLocalized() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDelta_StringLong(t *testing.T) {
+	type args struct {
+		locale measure.Locale
+	}
+	tests := []struct {
+		name string
+		d    Delta
+		args args
+		want string
+	}{
+		{
+			name: "Should use German names",
+			d:    NewDeltaFromKelvin(3),
+			args: args{
+				locale: measure.German,
+			},
+			want: "3\u00a0Kelvin",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.d.StringLong(tt.args.locale); got != tt.want {
+				t.Errorf("StringLong() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
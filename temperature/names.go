@@ -0,0 +1,30 @@
+package temperature
+
+import "github.com/alancesar/gogram/measure"
+
+var names = measure.NameCatalog{
+	string(Celsius): {
+		"en":    {Singular: "degree Celsius", Plural: "degrees Celsius"},
+		"pt-BR": {Singular: "grau Celsius", Plural: "graus Celsius"},
+		"de":    {Singular: "Grad Celsius", Plural: "Grad Celsius"},
+		"fr":    {Singular: "degré Celsius", Plural: "degrés Celsius"},
+	},
+	string(Fahrenheit): {
+		"en":    {Singular: "degree Fahrenheit", Plural: "degrees Fahrenheit"},
+		"pt-BR": {Singular: "grau Fahrenheit", Plural: "graus Fahrenheit"},
+		"de":    {Singular: "Grad Fahrenheit", Plural: "Grad Fahrenheit"},
+		"fr":    {Singular: "degré Fahrenheit", Plural: "degrés Fahrenheit"},
+	},
+	string(Kelvin): {
+		"en":    {Singular: "kelvin", Plural: "kelvins"},
+		"pt-BR": {Singular: "kelvin", Plural: "kelvins"},
+		"de":    {Singular: "Kelvin", Plural: "Kelvin"},
+		"fr":    {Singular: "kelvin", Plural: "kelvins"},
+	},
+	string(Rankine): {
+		"en":    {Singular: "degree Rankine", Plural: "degrees Rankine"},
+		"pt-BR": {Singular: "grau Rankine", Plural: "graus Rankine"},
+		"de":    {Singular: "Grad Rankine", Plural: "Grad Rankine"},
+		"fr":    {Singular: "degré Rankine", Plural: "degrés Rankine"},
+	},
+}
@@ -1,25 +1,57 @@
 package temperature
 
 import (
+	"database/sql/driver"
+	"encoding/xml"
+	"errors"
+	"flag"
 	"fmt"
 	"github.com/alancesar/gogram/measure"
 	"github.com/alancesar/gogram/numeric"
+	"math"
 )
 
 const (
 	Celsius    Unit = "°C"
 	Fahrenheit Unit = "°F"
+	Kelvin     Unit = "K"
+	Rankine    Unit = "°R"
+
+	kelvinOffset  = 273.15
+	rankineOffset = 459.67
+	precision     = 10
 )
 
 var (
-	parsers = measure.ParserMap[Temperature]{
-		"c":  NewFromCelsius,
-		"ºc": NewFromCelsius,
-		"°c": NewFromCelsius,
-		"f":  NewFromFahrenheit,
-		"ºf": NewFromFahrenheit,
-		"°f": NewFromFahrenheit,
-	}
+	// DefaultUnit is the unit of bare JSON numbers and of JSON objects
+	// without unit.
+	DefaultUnit = Celsius
+
+	// units are the temperature units, as listed by Set.
+	units = []Unit{Celsius, Fahrenheit, Kelvin, Rankine}
+
+	parsers = measure.NewRegistry(measure.ParserMap[Temperature]{
+		"c":          NewFromCelsius,
+		"ºc":         NewFromCelsius,
+		"°c":         NewFromCelsius,
+		"celsius":    NewFromCelsius,
+		"centigrade": NewFromCelsius,
+		"f":          NewFromFahrenheit,
+		"ºf":         NewFromFahrenheit,
+		"°f":         NewFromFahrenheit,
+		"fahrenheit": NewFromFahrenheit,
+		"k":          NewFromKelvin,
+		"kelvin":     NewFromKelvin,
+		"kelvins":    NewFromKelvin,
+		"°r":         NewFromRankine,
+		"ºr":         NewFromRankine,
+		"ra":         NewFromRankine,
+		"rankine":    NewFromRankine,
+	})
+
+	ErrBelowAbsoluteZero = errors.New("temperature below absolute zero")
+
+	errNotProportional = fmt.Errorf("%w: temperature units cannot be defined by a factor", measure.ErrInvalidUnit)
 )
 
 type (
@@ -29,10 +61,88 @@ type (
 		unit                Unit
 		celsius, fahrenheit float64
 	}
+
+	NullTemperature = measure.Null[Temperature]
+
+	// dimension only accepts aliases, since temperature scales are not
+	// proportional to each other.
+	dimension struct {
+		*measure.Registry[Temperature]
+	}
 )
 
+func init() {
+	parsers.RegisterNames(names)
+	deltaParsers.RegisterNames(names)
+	measure.RegisterDimension("temperature", dimension{parsers})
+}
+
+func (d dimension) ParseMeasurable(input string) (measure.Measurable, error) {
+	return Parse(input)
+}
+
+func (d dimension) Resolve(unit string) (float64, measure.System, error) {
+	return 0, 0, errNotProportional
+}
+
+func (d dimension) RegisterUnit(string, float64, measure.System, ...string) error {
+	return errNotProportional
+}
+
 func NewFromString(input string) Temperature {
-	return parsers.Parse(input)
+	t, _ := Parse(input)
+	return t
+}
+
+func Parse(input string) (Temperature, error) {
+	t, err := parsers.ParseE(input)
+	if err != nil {
+		return Temperature{}, err
+	}
+
+	if err := t.Validate(); err != nil {
+		return Temperature{}, err
+	}
+
+	return t, nil
+}
+
+// ParseLenient works like Parse, but also accepts spelled out inputs such as
+// "seventy degrees Fahrenheit".
+func ParseLenient(input string) (parsed Temperature, approximate bool, err error) {
+	if parsed, err := Parse(input); err == nil {
+		return parsed, false, nil
+	}
+
+	t, approximate, err := parsers.ParseLenient(input)
+	if err != nil {
+		return Temperature{}, false, err
+	}
+
+	if err := t.Validate(); err != nil {
+		return Temperature{}, false, err
+	}
+
+	return t, approximate, nil
+}
+
+// ParseLocalized parses input written in locale, such as "21,5 °C" in German.
+func ParseLocalized(input string, locale measure.Locale) (Temperature, error) {
+	return measure.ParseLocalized(Parse, input, locale)
+}
+
+// Var defines a temperature flag with the specified name, default value and
+// usage string on flag.CommandLine, storing its value in p.
+func Var(p *Temperature, name string, value Temperature, usage string) {
+	*p = value
+	flag.Var(p, name, usage)
+}
+
+// RegisterAlias makes alias parse the same way as unit. Temperature scales
+// are not proportional to each other, so unlike mass and volume no new units
+// can be registered.
+func RegisterAlias(alias string, unit Unit) error {
+	return parsers.RegisterAlias(alias, string(unit))
 }
 
 func NewFromCelsius(value float64) Temperature {
@@ -51,6 +161,54 @@ func NewFromFahrenheit(value float64) Temperature {
 	}
 }
 
+func NewFromKelvin(value float64) Temperature {
+	return Temperature{
+		unit:       Kelvin,
+		celsius:    value - kelvinOffset,
+		fahrenheit: (value * 1.8) - rankineOffset,
+	}
+}
+
+func NewFromRankine(value float64) Temperature {
+	return Temperature{
+		unit:       Rankine,
+		celsius:    (value - rankineOffset - 32) / 1.8,
+		fahrenheit: value - rankineOffset,
+	}
+}
+
+// Validate reports whether t lies at or above absolute zero. Constructors
+// do not validate their input, while Parse and UnmarshalJSON reject such
+// temperatures with ErrBelowAbsoluteZero.
+func (t Temperature) Validate() error {
+	if t.Kelvin() < 0 {
+		return fmt.Errorf("%w: %s", ErrBelowAbsoluteZero, t)
+	}
+
+	return nil
+}
+
+// System returns measure.Metric for temperatures created in Celsius or
+// Kelvin and measure.Imperial for those created in Fahrenheit or Rankine.
+func (t Temperature) System() measure.System {
+	return systemOf(t.findBestUnit())
+}
+
+// Base returns t in degrees Celsius.
+func (t Temperature) Base() float64 {
+	return t.celsius
+}
+
+// FromBase returns a temperature of value degrees Celsius, expressed in
+// Celsius for measure.Metric and in Fahrenheit otherwise.
+func (t Temperature) FromBase(value float64, system measure.System) Temperature {
+	if system == measure.Metric {
+		return NewFromCelsius(value)
+	}
+
+	return NewFromFahrenheit((value * 1.8) + 32)
+}
+
 func (t Temperature) IsZero() bool {
 	return t.celsius == 0 && t.fahrenheit == 0
 }
@@ -63,6 +221,67 @@ func (t Temperature) Fahrenheit() float64 {
 	return t.fahrenheit
 }
 
+func (t Temperature) Kelvin() float64 {
+	return numeric.Round(t.celsius+kelvinOffset, precision)
+}
+
+func (t Temperature) Rankine() float64 {
+	return numeric.Round(t.fahrenheit+rankineOffset, precision)
+}
+
+// Add raises t by d, keeping the unit t was created in.
+func (t Temperature) Add(d Delta) Temperature {
+	unit := t.findBestUnit()
+	value, _ := t.Float64In(unit)
+	change, _ := d.Float64In(unit)
+	return newFromUnit(unit, value+change)
+}
+
+// Sub returns the difference between t and other, expressed in the unit
+// t was created in.
+func (t Temperature) Sub(other Temperature) Delta {
+	unit := t.findBestUnit()
+	value, _ := t.Float64In(unit)
+	otherValue, _ := other.Float64In(unit)
+	return newDeltaFromUnit(unit, value-otherValue)
+}
+
+// StdDev returns the population standard deviation of values, as a delta in
+// Celsius or, when most of values are not metric, in Fahrenheit.
+func StdDev(values ...Temperature) Delta {
+	deviation, system := measure.Deviation(values...)
+	if system == measure.Metric {
+		return NewDeltaFromCelsius(deviation)
+	}
+
+	return NewDeltaFromFahrenheit(deviation * 1.8)
+}
+
+func (t Temperature) Compare(other Temperature) int {
+	return numeric.Compare(t.Kelvin(), other.Kelvin())
+}
+
+func (t Temperature) Less(other Temperature) bool {
+	return t.Compare(other) < 0
+}
+
+// Equal reports whether t and other represent the same temperature, even if
+// they were created in different units.
+func (t Temperature) Equal(other Temperature) bool {
+	return t.Compare(other) == 0
+}
+
+// EqualWithin reports whether t and other differ by at most tolerance.
+func (t Temperature) EqualWithin(other Temperature, tolerance Delta) bool {
+	return math.Abs(t.celsius-other.celsius) <= math.Abs(tolerance.Celsius())
+}
+
+// EqualWithinPercent reports whether t and other differ by at most percent
+// of the larger of them, both measured from absolute zero.
+func (t Temperature) EqualWithinPercent(other Temperature, percent float64) bool {
+	return numeric.WithinPercent(t.Kelvin(), other.Kelvin(), percent)
+}
+
 func (t Temperature) String() string {
 	unit := t.findBestUnit()
 	return t.StringIn(unit)
@@ -77,12 +296,38 @@ func (t Temperature) StringIn(unit Unit) string {
 	return fmt.Sprintf("%s%s", formatted, unit)
 }
 
+// StringLocalized works like String, but with the separators and unit
+// spacing of locale.
+func (t Temperature) StringLocalized(locale measure.Locale) string {
+	unit := t.findBestUnit()
+	value, err := t.Float64In(unit)
+	if err != nil {
+		return ""
+	}
+	return locale.Format(value, names.Abbreviation(string(unit), locale))
+}
+
+// StringLong works like StringLocalized, but with the long name of the unit,
+// such as "21 graus Celsius".
+func (t Temperature) StringLong(locale measure.Locale) string {
+	unit := t.findBestUnit()
+	value, err := t.Float64In(unit)
+	if err != nil {
+		return ""
+	}
+	return names.FormatLong(value, "temperature", string(unit), locale)
+}
+
 func (t Temperature) Float64In(unit Unit) (float64, error) {
 	switch unit {
 	case Celsius:
 		return t.Celsius(), nil
 	case Fahrenheit:
 		return t.Fahrenheit(), nil
+	case Kelvin:
+		return t.Kelvin(), nil
+	case Rankine:
+		return t.Rankine(), nil
 	default:
 		return 0, fmt.Errorf("%s is an invalid unit for temperature", unit)
 	}
@@ -92,14 +337,89 @@ func (t Temperature) MarshalJSON() ([]byte, error) {
 	return measure.Marshal(t)
 }
 
+// MarshalJSONObject marshals t to a JSON object such as
+// {"value":21.5,"unit":"°C","system":"metric"}. measure.Object uses it.
+func (t Temperature) MarshalJSONObject() ([]byte, error) {
+	unit := t.findBestUnit()
+	value, _ := t.Float64In(unit)
+	return measure.MarshalObject(value, string(unit), t.System())
+}
+
 func (t *Temperature) UnmarshalJSON(bytes []byte) error {
-	return measure.Unmarshal(t, NewFromString, bytes)
+	return measure.UnmarshalWithDefault(t, Parse, string(DefaultUnit), nil, bytes)
+}
+
+func (t Temperature) MarshalText() ([]byte, error) {
+	return measure.MarshalText(t)
+}
+
+func (t *Temperature) UnmarshalText(text []byte) error {
+	return measure.UnmarshalText(t, Parse, text)
+}
+
+func (t Temperature) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return measure.MarshalXMLAttr(t, name)
+}
+
+func (t *Temperature) UnmarshalXMLAttr(attr xml.Attr) error {
+	return measure.UnmarshalXMLAttr(t, Parse, attr)
+}
+
+// Set implements flag.Value, so *Temperature can be given to flag.Var.
+func (t *Temperature) Set(value string) error {
+	return measure.Set(t, Parse, symbols(), value)
+}
+
+// Get implements flag.Getter.
+func (t Temperature) Get() interface{} {
+	return t
+}
+
+func (t Temperature) Value() (driver.Value, error) {
+	return measure.Value(t)
+}
+
+func (t *Temperature) Scan(src interface{}) error {
+	return measure.Scan(t, Parse, src)
+}
+
+// symbols returns the symbols of the temperature units.
+func symbols() []string {
+	symbols := make([]string, 0, len(units))
+	for _, unit := range units {
+		symbols = append(symbols, string(unit))
+	}
+
+	return symbols
 }
 
 func (t Temperature) findBestUnit() Unit {
-	if t.unit == Celsius {
-		return Celsius
+	switch t.unit {
+	case Celsius, Kelvin, Rankine:
+		return t.unit
+	default:
+		return Fahrenheit
+	}
+}
+
+func newFromUnit(unit Unit, value float64) Temperature {
+	switch unit {
+	case Celsius:
+		return NewFromCelsius(value)
+	case Kelvin:
+		return NewFromKelvin(value)
+	case Rankine:
+		return NewFromRankine(value)
+	default:
+		return NewFromFahrenheit(value)
 	}
+}
 
-	return Fahrenheit
+func systemOf(unit Unit) measure.System {
+	switch unit {
+	case Celsius, Kelvin:
+		return measure.Metric
+	default:
+		return measure.Imperial
+	}
 }
@@ -1,7 +1,12 @@
 package temperature
 
 import (
+	"encoding/xml"
+	"errors"
+	"flag"
+	"github.com/alancesar/gogram/measure"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -65,6 +70,66 @@ func TestNewFromFahrenheit(t *testing.T) {
 	}
 }
 
+func TestNewFromKelvin(t *testing.T) {
+	type args struct {
+		value float64
+	}
+	tests := []struct {
+		name string
+		args args
+		want Temperature
+	}{
+		{
+			name: "Should parse from Kelvin",
+			args: args{
+				value: 300,
+			},
+			want: Temperature{
+				unit:       Kelvin,
+				celsius:    26.850000000000023,
+				fahrenheit: 80.32999999999998,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewFromKelvin(tt.args.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewFromKelvin() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewFromRankine(t *testing.T) {
+	type args struct {
+		value float64
+	}
+	tests := []struct {
+		name string
+		args args
+		want Temperature
+	}{
+		{
+			name: "Should parse from Rankine",
+			args: args{
+				value: 491.67,
+			},
+			want: Temperature{
+				unit:       Rankine,
+				celsius:    0,
+				fahrenheit: 32,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewFromRankine(tt.args.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewFromRankine() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestNewFromString(t *testing.T) {
 	type args struct {
 		input string
@@ -116,6 +181,48 @@ func TestNewFromString(t *testing.T) {
 			},
 			want: NewFromFahrenheit(1),
 		},
+		{
+			name: "Should parse from '-5°C' string",
+			args: args{
+				input: "-5°C",
+			},
+			want: NewFromCelsius(-5),
+		},
+		{
+			name: "Should parse from '\u22125°F' string",
+			args: args{
+				input: "\u22125°F",
+			},
+			want: NewFromFahrenheit(-5),
+		},
+		{
+			name: "Should parse from '300K' string",
+			args: args{
+				input: "300K",
+			},
+			want: NewFromKelvin(300),
+		},
+		{
+			name: "Should parse from '300 °R' string",
+			args: args{
+				input: "300 °R",
+			},
+			want: NewFromRankine(300),
+		},
+		{
+			name: "Should parse from '300 Ra' string",
+			args: args{
+				input: "300 Ra",
+			},
+			want: NewFromRankine(300),
+		},
+		{
+			name: "Should return empty if is below absolute zero",
+			args: args{
+				input: "-1K",
+			},
+			want: Temperature{},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -126,6 +233,200 @@ func TestNewFromString(t *testing.T) {
 	}
 }
 
+func TestParse(t *testing.T) {
+	type args struct {
+		input string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Temperature
+		wantErr error
+	}{
+		{
+			name: "Should parse from '1°C' string",
+			args: args{
+				input: "1°C",
+			},
+			want:    NewFromCelsius(1),
+			wantErr: nil,
+		},
+		{
+			name: "Should return error for empty input",
+			args: args{
+				input: "",
+			},
+			want:    Temperature{},
+			wantErr: measure.ErrEmptyInput,
+		},
+		{
+			name: "Should return error for malformed number",
+			args: args{
+				input: "x°C",
+			},
+			want:    Temperature{},
+			wantErr: measure.ErrMalformedNumber,
+		},
+		{
+			name: "Should return error for unknown unit",
+			args: args{
+				input: "1 xx",
+			},
+			want:    Temperature{},
+			wantErr: measure.ErrUnknownUnit,
+		},
+		{
+			name: "Should return error for temperatures below absolute zero",
+			args: args{
+				input: "-300°C",
+			},
+			want:    Temperature{},
+			wantErr: ErrBelowAbsoluteZero,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.args.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTemperature_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		temperature Temperature
+		wantErr     bool
+	}{
+		{
+			name:        "Should accept absolute zero",
+			temperature: NewFromKelvin(0),
+			wantErr:     false,
+		},
+		{
+			name:        "Should accept absolute zero in Fahrenheit",
+			temperature: NewFromFahrenheit(-459.67),
+			wantErr:     false,
+		},
+		{
+			name:        "Should reject temperatures below absolute zero",
+			temperature: NewFromCelsius(-273.16),
+			wantErr:     true,
+		},
+		{
+			name:        "Should reject negative Rankine",
+			temperature: NewFromRankine(-1),
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.temperature.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTemperature_System(t *testing.T) {
+	tests := []struct {
+		name        string
+		temperature Temperature
+		want        measure.System
+	}{
+		{
+			name:        "Should return metric for Celsius",
+			temperature: NewFromCelsius(1),
+			want:        measure.Metric,
+		},
+		{
+			name:        "Should return metric for Kelvin",
+			temperature: NewFromKelvin(1),
+			want:        measure.Metric,
+		},
+		{
+			name:        "Should return imperial for Fahrenheit",
+			temperature: NewFromFahrenheit(1),
+			want:        measure.Imperial,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.temperature.System(); got != tt.want {
+				t.Errorf("System() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTemperature_Base(t *testing.T) {
+	tests := []struct {
+		name        string
+		temperature Temperature
+		want        float64
+	}{
+		{
+			name:        "Should return Celsius",
+			temperature: NewFromCelsius(20),
+			want:        20,
+		},
+		{
+			name:        "Should return Celsius from Fahrenheit",
+			temperature: NewFromFahrenheit(212),
+			want:        100,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.temperature.Base(); got != tt.want {
+				t.Errorf("Base() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTemperature_FromBase(t *testing.T) {
+	type args struct {
+		value  float64
+		system measure.System
+	}
+	tests := []struct {
+		name string
+		args args
+		want Temperature
+	}{
+		{
+			name: "Should create Celsius temperature",
+			args: args{
+				value:  20,
+				system: measure.Metric,
+			},
+			want: NewFromCelsius(20),
+		},
+		{
+			name: "Should create Fahrenheit temperature",
+			args: args{
+				value:  100,
+				system: measure.Imperial,
+			},
+			want: NewFromFahrenheit(212),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := (Temperature{}).FromBase(tt.args.value, tt.args.system); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FromBase() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestTemperature_IsZero(t *testing.T) {
 	type fields struct {
 		celsius float64
@@ -222,118 +523,413 @@ func TestTemperature_Fahrenheit(t *testing.T) {
 	}
 }
 
-func TestTemperature_String(t *testing.T) {
+func TestTemperature_Kelvin(t *testing.T) {
 	type fields struct {
-		unit       Unit
-		celsius    float64
-		fahrenheit float64
+		celsius float64
 	}
 	tests := []struct {
 		name   string
 		fields fields
-		want   string
+		want   float64
 	}{
 		{
-			name: "Should print 15°C",
-			fields: fields{
-				unit:    Celsius,
-				celsius: 15,
-			},
-			want: "15°C",
-		},
-		{
-			name: "Should print 15°F",
+			name: "Should get Kelvin value properly",
 			fields: fields{
-				unit:       Fahrenheit,
-				fahrenheit: 15,
+				celsius: 26.85,
 			},
-			want: "15°F",
+			want: 300,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			temperature := Temperature{
-				unit:       tt.fields.unit,
-				celsius:    tt.fields.celsius,
-				fahrenheit: tt.fields.fahrenheit,
+				celsius: tt.fields.celsius,
 			}
-			if got := temperature.String(); got != tt.want {
-				t.Errorf("String() = %v, want %v", got, tt.want)
+			if got := temperature.Kelvin(); got != tt.want {
+				t.Errorf("Kelvin() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-func TestTemperature_MarshalJSON(t *testing.T) {
+func TestTemperature_Rankine(t *testing.T) {
 	type fields struct {
-		celsius float64
+		fahrenheit float64
 	}
 	tests := []struct {
-		name    string
-		fields  fields
-		want    []byte
-		wantErr bool
+		name   string
+		fields fields
+		want   float64
 	}{
 		{
-			name: "Should marshal properly",
+			name: "Should get Rankine value properly",
 			fields: fields{
-				celsius: 15,
+				fahrenheit: 32,
 			},
-			want:    []byte(`"15°C"`),
-			wantErr: false,
+			want: 491.67,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			temperature := NewFromCelsius(tt.fields.celsius)
-			got, err := temperature.MarshalJSON()
-			if (err != nil) != tt.wantErr {
-				t.Errorf("MarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
-				return
+			temperature := Temperature{
+				fahrenheit: tt.fields.fahrenheit,
 			}
-			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("MarshalJSON() got = %v, want %v", got, tt.want)
+			if got := temperature.Rankine(); got != tt.want {
+				t.Errorf("Rankine() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-func TestTemperature_UnmarshalJSON(t *testing.T) {
+func TestTemperature_Add(t *testing.T) {
 	type args struct {
-		bytes []byte
+		delta Delta
 	}
 	tests := []struct {
-		name    string
-		args    args
-		want    Temperature
-		wantErr bool
+		name        string
+		temperature Temperature
+		args        args
+		want        string
 	}{
 		{
-			name: "Should unmarshal properly",
+			name:        "Should raise 65°C by 5°C",
+			temperature: NewFromCelsius(65),
 			args: args{
-				bytes: []byte(`"23ºC"`),
+				delta: NewDeltaFromCelsius(5),
 			},
-			want:    NewFromCelsius(23),
-			wantErr: false,
+			want: "70°C",
+		},
+		{
+			name:        "Should raise 150°F by 10°C",
+			temperature: NewFromFahrenheit(150),
+			args: args{
+				delta: NewDeltaFromCelsius(10),
+			},
+			want: "168°F",
+		},
+		{
+			name:        "Should lower 300K by 9°F",
+			temperature: NewFromKelvin(300),
+			args: args{
+				delta: NewDeltaFromFahrenheit(-9),
+			},
+			want: "295K",
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			temp := &Temperature{}
-			if err := temp.UnmarshalJSON(tt.args.bytes); (err != nil) != tt.wantErr {
-				t.Errorf("UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
-			}
-
-			if !reflect.DeepEqual(*temp, tt.want) {
-				t.Errorf("MarshalJSON() got = %v, want %v", temp, tt.want)
+			if got := tt.temperature.Add(tt.args.delta); got.String() != tt.want {
+				t.Errorf("Add() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-func TestTemperature_StringIn(t *testing.T) {
-	type fields struct {
-		celsius float64
+func TestTemperature_Sub(t *testing.T) {
+	type args struct {
+		other Temperature
+	}
+	tests := []struct {
+		name        string
+		temperature Temperature
+		args        args
+		want        Delta
+	}{
+		{
+			name:        "Should get 10°C between 20°C and 10°C",
+			temperature: NewFromCelsius(20),
+			args: args{
+				other: NewFromCelsius(10),
+			},
+			want: NewDeltaFromCelsius(10),
+		},
+		{
+			name:        "Should get -18°F between 32°F and 10°C",
+			temperature: NewFromFahrenheit(32),
+			args: args{
+				other: NewFromCelsius(10),
+			},
+			want: NewDeltaFromFahrenheit(-18),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.temperature.Sub(tt.args.other); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Sub() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTemperature_Compare(t *testing.T) {
+	type args struct {
+		other Temperature
+	}
+	tests := []struct {
+		name        string
+		temperature Temperature
+		args        args
+		want        int
+	}{
+		{
+			name:        "Should return 0 for 0°C and 32°F",
+			temperature: NewFromCelsius(0),
+			args: args{
+				other: NewFromFahrenheit(32),
+			},
+			want: 0,
+		},
+		{
+			name:        "Should return -1 if less",
+			temperature: NewFromCelsius(-5),
+			args: args{
+				other: NewFromFahrenheit(32),
+			},
+			want: -1,
+		},
+		{
+			name:        "Should return 1 if greater",
+			temperature: NewFromKelvin(300),
+			args: args{
+				other: NewFromCelsius(20),
+			},
+			want: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.temperature.Compare(tt.args.other); got != tt.want {
+				t.Errorf("Compare() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTemperature_EqualWithin(t *testing.T) {
+	type args struct {
+		other     Temperature
+		tolerance Delta
+	}
+	tests := []struct {
+		name        string
+		temperature Temperature
+		args        args
+		want        bool
+	}{
+		{
+			name:        "Should return true if within tolerance",
+			temperature: NewFromCelsius(67),
+			args: args{
+				other:     NewFromFahrenheit(152),
+				tolerance: NewDeltaFromFahrenheit(1),
+			},
+			want: true,
+		},
+		{
+			name:        "Should return false if out of tolerance",
+			temperature: NewFromCelsius(67),
+			args: args{
+				other:     NewFromFahrenheit(150),
+				tolerance: NewDeltaFromFahrenheit(1),
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.temperature.EqualWithin(tt.args.other, tt.args.tolerance); got != tt.want {
+				t.Errorf("EqualWithin() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTemperature_String(t *testing.T) {
+	type fields struct {
+		unit       Unit
+		celsius    float64
+		fahrenheit float64
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   string
+	}{
+		{
+			name: "Should print 15°C",
+			fields: fields{
+				unit:    Celsius,
+				celsius: 15,
+			},
+			want: "15°C",
+		},
+		{
+			name: "Should print 15°F",
+			fields: fields{
+				unit:       Fahrenheit,
+				fahrenheit: 15,
+			},
+			want: "15°F",
+		},
+		{
+			name: "Should print 300K",
+			fields: fields{
+				unit:    Kelvin,
+				celsius: 26.85,
+			},
+			want: "300K",
+		},
+		{
+			name: "Should print 500°R",
+			fields: fields{
+				unit:       Rankine,
+				fahrenheit: 40.33,
+			},
+			want: "500°R",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			temperature := Temperature{
+				unit:       tt.fields.unit,
+				celsius:    tt.fields.celsius,
+				fahrenheit: tt.fields.fahrenheit,
+			}
+			if got := temperature.String(); got != tt.want {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTemperature_MarshalJSON(t *testing.T) {
+	type fields struct {
+		celsius float64
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name: "Should marshal properly",
+			fields: fields{
+				celsius: 15,
+			},
+			want:    []byte(`"15°C"`),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			temperature := NewFromCelsius(tt.fields.celsius)
+			got, err := temperature.MarshalJSON()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalJSON() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTemperature_UnmarshalJSON(t *testing.T) {
+	type args struct {
+		bytes []byte
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Temperature
+		wantErr bool
+	}{
+		{
+			name: "Should unmarshal properly",
+			args: args{
+				bytes: []byte(`"23ºC"`),
+			},
+			want:    NewFromCelsius(23),
+			wantErr: false,
+		},
+		{
+			name: "Should unmarshal the object form",
+			args: args{
+				bytes: []byte(`{"value":72,"unit":"°F","system":"imperial"}`),
+			},
+			want:    NewFromFahrenheit(72),
+			wantErr: false,
+		},
+		{
+			name: "Should unmarshal bare numbers in the default unit",
+			args: args{
+				bytes: []byte("21.5"),
+			},
+			want:    NewFromCelsius(21.5),
+			wantErr: false,
+		},
+		{
+			name: "Should return error for unknown unit",
+			args: args{
+				bytes: []byte(`"23 xx"`),
+			},
+			want:    Temperature{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			temp := &Temperature{}
+			if err := temp.UnmarshalJSON(tt.args.bytes); (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !reflect.DeepEqual(*temp, tt.want) {
+				t.Errorf("MarshalJSON() got = %v, want %v", temp, tt.want)
+			}
+		})
+	}
+}
+
+func TestTemperature_MarshalJSONObject(t *testing.T) {
+	tests := []struct {
+		name     string
+		quantity Temperature
+		want     []byte
+		wantErr  bool
+	}{
+		{
+			name:     "Should marshal NewFromCelsius(21.5) as object",
+			quantity: NewFromCelsius(21.5),
+			want:     []byte(`{"value":21.5,"unit":"°C","system":"metric"}`),
+			wantErr:  false,
+		},
+		{
+			name:     "Should marshal NewFromFahrenheit(72) as object",
+			quantity: NewFromFahrenheit(72),
+			want:     []byte(`{"value":72,"unit":"°F","system":"imperial"}`),
+			wantErr:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.quantity.MarshalJSONObject()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarshalJSONObject() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalJSONObject() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTemperature_StringIn(t *testing.T) {
+	type fields struct {
+		celsius float64
 	}
 	type args struct {
 		unit Unit
@@ -374,6 +970,26 @@ func TestTemperature_StringIn(t *testing.T) {
 			},
 			want: "",
 		},
+		{
+			name: "Should print 283.15K",
+			fields: fields{
+				celsius: 10,
+			},
+			args: args{
+				unit: Kelvin,
+			},
+			want: "283.15K",
+		},
+		{
+			name: "Should print 509.67°R",
+			fields: fields{
+				celsius: 10,
+			},
+			args: args{
+				unit: Rankine,
+			},
+			want: "509.67°R",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -432,6 +1048,28 @@ func TestTemperature_Float64In(t *testing.T) {
 			want:    0,
 			wantErr: true,
 		},
+		{
+			name: "Should get 283.15K",
+			fields: fields{
+				celsius: 10,
+			},
+			args: args{
+				unit: Kelvin,
+			},
+			want:    283.15,
+			wantErr: false,
+		},
+		{
+			name: "Should get 509.67°R",
+			fields: fields{
+				celsius: 10,
+			},
+			args: args{
+				unit: Rankine,
+			},
+			want:    509.67,
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -447,3 +1085,475 @@ func TestTemperature_Float64In(t *testing.T) {
 		})
 	}
 }
+
+func TestTemperature_aggregates(t *testing.T) {
+	values := []Temperature{NewFromFahrenheit(150), NewFromFahrenheit(154), NewFromCelsius(65)}
+	tests := []struct {
+		name string
+		got  Temperature
+		want Temperature
+	}{
+		{
+			name: "Should average in Fahrenheit",
+			got:  measure.Mean(values...),
+			want: NewFromFahrenheit(151),
+		},
+		{
+			name: "Should get the maximum",
+			got:  measure.Max(values...),
+			want: NewFromFahrenheit(154),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.got.Equal(tt.want) || tt.got.System() != tt.want.System() {
+				t.Errorf("got = %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStdDev(t *testing.T) {
+	type args struct {
+		values []Temperature
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "Should return the deviation in Fahrenheit",
+			args: args{
+				values: []Temperature{NewFromFahrenheit(32), NewFromFahrenheit(212), NewFromCelsius(0), NewFromCelsius(100)},
+			},
+			want: "90°F",
+		},
+		{
+			name: "Should return the deviation in Celsius",
+			args: args{
+				values: []Temperature{NewFromCelsius(18), NewFromCelsius(22)},
+			},
+			want: "2°C",
+		},
+		{
+			name: "Should return zero if empty",
+			args: args{
+				values: nil,
+			},
+			want: "0°C",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := StdDev(tt.args.values...).String(); got != tt.want {
+				t.Errorf("StdDev() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTemperature_Scan(t *testing.T) {
+	want := NewFromKelvin(300)
+	value, err := want.Value()
+	if err != nil {
+		t.Fatalf("Value() error = %v", err)
+	}
+	var got Temperature
+	if err := got.Scan(value); err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Scan() got = %v, want %v", got, want)
+	}
+}
+
+func TestNullTemperature(t *testing.T) {
+	var got NullTemperature
+	if err := got.UnmarshalJSON([]byte(`"67°C"`)); err != nil {
+		t.Fatalf("UnmarshalJSON() error = %v", err)
+	}
+	if want := measure.NewNull(NewFromCelsius(67)); !reflect.DeepEqual(got, want) {
+		t.Errorf("UnmarshalJSON() got = %v, want %v", got, want)
+	}
+	if bytes, _ := got.MarshalJSON(); string(bytes) != `"67°C"` {
+		t.Errorf("MarshalJSON() got = %s, want %s", bytes, `"67°C"`)
+	}
+}
+
+func TestTemperature_xml(t *testing.T) {
+	type document struct {
+		XMLName   xml.Name    `xml:"document"`
+		Attribute Temperature `xml:"target,attr"`
+		Element   Temperature `xml:"target"`
+	}
+	tests := []struct {
+		name string
+		doc  document
+		want string
+	}{
+		{
+			name: "Should encode and decode attributes and elements",
+			doc: document{
+				Attribute: NewFromCelsius(67),
+				Element:   NewFromCelsius(67),
+			},
+			want: `<document target="67°C"><target>67°C</target></document>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded, err := xml.Marshal(tt.doc)
+			if err != nil {
+				t.Fatalf("xml.Marshal() error = %v", err)
+			}
+			if string(encoded) != tt.want {
+				t.Errorf("xml.Marshal() got = %s, want %s", encoded, tt.want)
+			}
+
+			var decoded document
+			if err := xml.Unmarshal(encoded, &decoded); err != nil {
+				t.Fatalf("xml.Unmarshal() error = %v", err)
+			}
+			if !reflect.DeepEqual(decoded.Attribute, tt.doc.Attribute) || !reflect.DeepEqual(decoded.Element, tt.doc.Element) {
+				t.Errorf("xml.Unmarshal() got = %v, want %v", decoded, tt.doc)
+			}
+		})
+	}
+}
+
+func TestVar(t *testing.T) {
+	defer func(commandLine *flag.FlagSet) {
+		flag.CommandLine = commandLine
+	}(flag.CommandLine)
+	flag.CommandLine = flag.NewFlagSet("test", flag.ContinueOnError)
+
+	var got Temperature
+	Var(&got, "value", NewFromCelsius(67), "usage")
+	if err := flag.Set("value", "72C"); err != nil {
+		t.Errorf("flag.Set() error = %v", err)
+	}
+	if want := NewFromCelsius(72); !reflect.DeepEqual(flag.Lookup("value").Value.(flag.Getter).Get(), want) {
+		t.Errorf("Var() got = %v, want %v", got, want)
+	}
+
+	wantMessage := "accepted units: °C, °F, K, °R"
+	if err := flag.Set("value", "5 xx"); err == nil || !strings.HasSuffix(err.Error(), wantMessage) {
+		t.Errorf("flag.Set() error = %v, want message %v", err, wantMessage)
+	}
+}
+
+func TestRegisterAlias(t *testing.T) {
+	defer func(original *measure.Registry[Temperature]) {
+		parsers = original
+	}(parsers)
+	parsers = measure.NewRegistry(measure.ParserMap[Temperature]{"°c": NewFromCelsius})
+
+	if err := RegisterAlias("degC", Celsius); err != nil {
+		t.Fatalf("RegisterAlias() error = %v", err)
+	}
+
+	got, err := Parse("21 degc")
+	if err != nil {
+		t.Errorf("Parse() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, NewFromCelsius(21)) {
+		t.Errorf("Parse() got = %v, want %v", got, NewFromCelsius(21))
+	}
+
+	if err := RegisterAlias("degF", Fahrenheit); !errors.Is(err, measure.ErrUnknownUnit) {
+		t.Errorf("RegisterAlias() error = %v, want %v", err, measure.ErrUnknownUnit)
+	}
+}
+
+func TestLoadDefinitions(t *testing.T) {
+	defer func(original *measure.Registry[Temperature]) {
+		parsers = original
+		measure.RegisterDimension("temperature", dimension{parsers})
+	}(parsers)
+	parsers = measure.NewRegistry(measure.ParserMap[Temperature]{"°c": NewFromCelsius})
+	measure.RegisterDimension("temperature", dimension{parsers})
+
+	err := measure.LoadDefinitions(strings.NewReader(`{"temperature": {"aliases": {"degC": "°C"}}}`))
+	if err != nil {
+		t.Fatalf("LoadDefinitions() error = %v", err)
+	}
+
+	if got := NewFromString("21 degc"); !reflect.DeepEqual(got, NewFromCelsius(21)) {
+		t.Errorf("Parse() got = %v, want %v", got, NewFromCelsius(21))
+	}
+
+	err = measure.LoadDefinitions(strings.NewReader(`{"temperature": {"units": [{"name": "mark", "value": 14, "unit": "°C"}]}}`))
+	if !errors.Is(err, measure.ErrInvalidUnit) {
+		t.Errorf("LoadDefinitions() error = %v, want %v", err, measure.ErrInvalidUnit)
+	}
+}
+
+func TestParseLenient(t *testing.T) {
+	type args struct {
+		input string
+	}
+	tests := []struct {
+		name            string
+		args            args
+		want            Temperature
+		wantApproximate bool
+		wantErr         bool
+	}{
+		{
+			name: "Should parse 'seventy degrees Fahrenheit'",
+			args: args{
+				input: "seventy degrees Fahrenheit",
+			},
+			want:            NewFromFahrenheit(70),
+			wantApproximate: false,
+			wantErr:         false,
+		},
+		{
+			name: "Should return error for 'minus'",
+			args: args{
+				input: "minus",
+			},
+			want:            Temperature{},
+			wantApproximate: false,
+			wantErr:         true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, approximate, err := ParseLenient(tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseLenient() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseLenient() got = %v, want %v", got, tt.want)
+			}
+			if approximate != tt.wantApproximate {
+				t.Errorf("ParseLenient() approximate = %v, want %v", approximate, tt.wantApproximate)
+			}
+		})
+	}
+}
+
+func TestFindAll(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []measure.Match
+	}{
+		{
+			name: "Should find every temperature in the text",
+			text: "Mash at 67°C for 60 minutes, then heat to 170 degrees",
+			want: []measure.Match{
+				{
+					Dimension: "temperature",
+					Value:     NewFromCelsius(67),
+					Text:      "67°C",
+					Start:     8,
+					End:       13,
+				},
+			},
+		},
+		{
+			name: "Should ignore single-letter units",
+			text: "Ran a 5k, then 4 c of water at 20 F",
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := measure.FindAll(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FindAll() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRewrite(t *testing.T) {
+	type args struct {
+		text    string
+		system  measure.System
+		options measure.RewriteOptions
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "Should convert Fahrenheit to Celsius",
+			args: args{
+				text:    "Mash at 152°F, then mash out at 76°C.",
+				system:  measure.Metric,
+				options: measure.RewriteOptions{Precision: 1, KeepOriginal: false},
+			},
+			want: "Mash at 66.7°C, then mash out at 76°C.",
+		},
+		{
+			name: "Should convert Celsius to Fahrenheit",
+			args: args{
+				text:    "Pitch at 20 °C.",
+				system:  measure.Imperial,
+				options: measure.RewriteOptions{Precision: 0, KeepOriginal: true},
+			},
+			want: "Pitch at 68°F (20 °C).",
+		},
+		{
+			name: "Should convert both ends of ranges",
+			args: args{
+				text:    "Mash at 65-68°C.",
+				system:  measure.Imperial,
+				options: measure.RewriteOptions{Precision: 1, KeepOriginal: false},
+			},
+			want: "Mash at 149°F-154.4°F.",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := measure.Rewrite(tt.args.text, tt.args.system, tt.args.options); got != tt.want {
+				t.Errorf("Rewrite() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseLocalized(t *testing.T) {
+	type args struct {
+		input  string
+		locale measure.Locale
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Temperature
+		wantErr bool
+	}{
+		{
+			name: "Should parse German decimals",
+			args: args{
+				input:  "21,5 °C",
+				locale: measure.German,
+			},
+			want:    NewFromCelsius(21.5),
+			wantErr: false,
+		},
+		{
+			name: "Should return error for unknown units",
+			args: args{
+				input:  "21,5 foo",
+				locale: measure.German,
+			},
+			want:    Temperature{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseLocalized(tt.args.input, tt.args.locale)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseLocalized() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseLocalized() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTemperature_StringLocalized(t *testing.T) {
+	type args struct {
+		locale measure.Locale
+	}
+	tests := []struct {
+		name string
+		t    Temperature
+		args args
+		want string
+	}{
+		{
+			name: "Should keep degrees attached in English",
+			t:    NewFromCelsius(21.5),
+			args: args{
+				locale: measure.English,
+			},
+			want: "21.5°C",
+		},
+		{
+			name: "Should space degrees in German",
+			t:    NewFromCelsius(21.5),
+			args: args{
+				locale: measure.German,
+			},
+			want: "21,5\u00a0°C",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.t.StringLocalized(tt.args.locale); got != tt.want {
+				t.Errorf("StringLocalized() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTemperature_StringLong(t *testing.T) {
+	type args struct {
+		locale measure.Locale
+	}
+	tests := []struct {
+		name string
+		t    Temperature
+		args args
+		want string
+	}{
+		{
+			name: "Should use English names",
+			t:    NewFromCelsius(21),
+			args: args{
+				locale: measure.English,
+			},
+			want: "21 degrees Celsius",
+		},
+		{
+			name: "Should use Brazilian names",
+			t:    NewFromFahrenheit(70),
+			args: args{
+				locale: measure.BrazilianPortuguese,
+			},
+			want: "70 graus Fahrenheit",
+		},
+		{
+			name: "Should use the French singular",
+			t:    NewFromCelsius(1),
+			args: args{
+				locale: measure.French,
+			},
+			want: "1\u00a0degré Celsius",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.t.StringLong(tt.args.locale); got != tt.want {
+				t.Errorf("StringLong() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_names(t *testing.T) {
+	for unit, byLocale := range names {
+		want := NewFromString("2 " + unit)
+		for tag, name := range byLocale {
+			for _, alias := range []string{name.Singular, name.Plural, name.Abbreviation} {
+				if alias == "" {
+					continue
+				}
+				if got := NewFromString("2 " + alias); !reflect.DeepEqual(got, want) {
+					t.Errorf("%s name %q of %q parsed as %v, want %v", tag, alias, unit, got, want)
+				}
+			}
+		}
+	}
+}
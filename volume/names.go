@@ -0,0 +1,102 @@
+package volume
+
+import "github.com/alancesar/gogram/measure"
+
+var names = measure.NameCatalog{
+	string(Milliliter): {
+		"en":    {Singular: "milliliter", Plural: "milliliters"},
+		"pt-BR": {Singular: "mililitro", Plural: "mililitros"},
+		"de":    {Singular: "Milliliter", Plural: "Milliliter"},
+		"fr":    {Singular: "millilitre", Plural: "millilitres"},
+	},
+	string(Liter): {
+		"en":    {Singular: "liter", Plural: "liters"},
+		"pt-BR": {Singular: "litro", Plural: "litros"},
+		"de":    {Singular: "Liter", Plural: "Liter"},
+		"fr":    {Singular: "litre", Plural: "litres"},
+	},
+	string(Hectoliter): {
+		"en":    {Singular: "hectoliter", Plural: "hectoliters"},
+		"pt-BR": {Singular: "hectolitro", Plural: "hectolitros"},
+		"de":    {Singular: "Hektoliter", Plural: "Hektoliter"},
+		"fr":    {Singular: "hectolitre", Plural: "hectolitres"},
+	},
+	string(CubicMeter): {
+		"en":    {Singular: "cubic meter", Plural: "cubic meters"},
+		"pt-BR": {Singular: "metro cúbico", Plural: "metros cúbicos"},
+		"de":    {Singular: "Kubikmeter", Plural: "Kubikmeter"},
+		"fr":    {Singular: "mètre cube", Plural: "mètres cubes"},
+	},
+	string(Teaspoon): {
+		"en":    {Singular: "teaspoon", Plural: "teaspoons"},
+		"pt-BR": {Singular: "colher de chá", Plural: "colheres de chá", Abbreviation: "c. chá"},
+		"de":    {Singular: "Teelöffel", Plural: "Teelöffel", Abbreviation: "TL"},
+		"fr":    {Singular: "cuillère à café", Plural: "cuillères à café", Abbreviation: "c. à c."},
+	},
+	string(Tablespoon): {
+		"en":    {Singular: "tablespoon", Plural: "tablespoons"},
+		"pt-BR": {Singular: "colher de sopa", Plural: "colheres de sopa", Abbreviation: "c. sopa"},
+		"de":    {Singular: "Esslöffel", Plural: "Esslöffel", Abbreviation: "EL"},
+		"fr":    {Singular: "cuillère à soupe", Plural: "cuillères à soupe", Abbreviation: "c. à s."},
+	},
+	string(Cup): {
+		"en":    {Singular: "cup", Plural: "cups"},
+		"pt-BR": {Singular: "xícara", Plural: "xícaras", Abbreviation: "xíc."},
+		"de":    {Singular: "Tasse", Plural: "Tassen"},
+		"fr":    {Singular: "tasse", Plural: "tasses"},
+	},
+	string(USFluidOunce): {
+		"en":    {Singular: "US fluid ounce", Plural: "US fluid ounces"},
+		"pt-BR": {Singular: "onça fluida americana", Plural: "onças fluidas americanas"},
+		"de":    {Singular: "US-Flüssigunze", Plural: "US-Flüssigunzen"},
+		"fr":    {Singular: "once liquide américaine", Plural: "onces liquides américaines"},
+	},
+	string(USPint): {
+		"en":    {Singular: "US pint", Plural: "US pints"},
+		"pt-BR": {Singular: "pinta americana", Plural: "pintas americanas"},
+		"de":    {Singular: "US-Pint", Plural: "US-Pints"},
+		"fr":    {Singular: "pinte américaine", Plural: "pintes américaines"},
+	},
+	string(USQuart): {
+		"en":    {Singular: "US quart", Plural: "US quarts"},
+		"pt-BR": {Singular: "quarto americano", Plural: "quartos americanos"},
+		"de":    {Singular: "US-Quart", Plural: "US-Quarts"},
+		"fr":    {Singular: "quart américain", Plural: "quarts américains"},
+	},
+	string(USGallon): {
+		"en":    {Singular: "US gallon", Plural: "US gallons"},
+		"pt-BR": {Singular: "galão americano", Plural: "galões americanos"},
+		"de":    {Singular: "US-Gallone", Plural: "US-Gallonen"},
+		"fr":    {Singular: "gallon américain", Plural: "gallons américains"},
+	},
+	string(ImperialFluidOunce): {
+		"en":    {Singular: "imperial fluid ounce", Plural: "imperial fluid ounces"},
+		"pt-BR": {Singular: "onça fluida imperial", Plural: "onças fluidas imperiais"},
+		"de":    {Singular: "imperiale Flüssigunze", Plural: "imperiale Flüssigunzen"},
+		"fr":    {Singular: "once liquide impériale", Plural: "onces liquides impériales"},
+	},
+	string(ImperialPint): {
+		"en":    {Singular: "imperial pint", Plural: "imperial pints"},
+		"pt-BR": {Singular: "pinta imperial", Plural: "pintas imperiais"},
+		"de":    {Singular: "imperiales Pint", Plural: "imperiale Pints"},
+		"fr":    {Singular: "pinte impériale", Plural: "pintes impériales"},
+	},
+	string(ImperialQuart): {
+		"en":    {Singular: "imperial quart", Plural: "imperial quarts"},
+		"pt-BR": {Singular: "quarto imperial", Plural: "quartos imperiais"},
+		"de":    {Singular: "imperiales Quart", Plural: "imperiale Quarts"},
+		"fr":    {Singular: "quart impérial", Plural: "quarts impériaux"},
+	},
+	string(ImperialGallon): {
+		"en":    {Singular: "imperial gallon", Plural: "imperial gallons"},
+		"pt-BR": {Singular: "galão imperial", Plural: "galões imperiais"},
+		"de":    {Singular: "imperiale Gallone", Plural: "imperiale Gallonen"},
+		"fr":    {Singular: "gallon impérial", Plural: "gallons impériaux"},
+	},
+	string(Barrel): {
+		"en":    {Singular: "barrel", Plural: "barrels"},
+		"pt-BR": {Singular: "barril", Plural: "barris"},
+		"de":    {Singular: "Barrel", Plural: "Barrel"},
+		"fr":    {Singular: "baril", Plural: "barils"},
+	},
+}
@@ -1,43 +1,282 @@
 package volume
 
 import (
+	"database/sql/driver"
+	"encoding/xml"
+	"flag"
 	"fmt"
 	"github.com/alancesar/gogram/measure"
 	"github.com/alancesar/gogram/numeric"
+	"math"
+	"sync"
 )
 
 const (
-	Milliliter Unit = "ml"
-	Liter      Unit = "l"
-	Gallon     Unit = "gal"
-	Ounce      Unit = "fl. Oz"
-
-	millilitersInLiters = 1000
-	litersInGallons     = 4.54609
-	ouncesInGallons     = 160
+	Milliliter         Unit = "ml"
+	Liter              Unit = "l"
+	ImperialGallon     Unit = "imp gal"
+	ImperialFluidOunce Unit = "imp fl oz"
+	USGallon           Unit = "US gal"
+	USFluidOunce       Unit = "US fl oz"
+	Hectoliter         Unit = "hl"
+	CubicMeter         Unit = "m³"
+	Teaspoon           Unit = "tsp"
+	Tablespoon         Unit = "tbsp"
+	Cup                Unit = "cup"
+	USPint             Unit = "US pt"
+	USQuart            Unit = "US qt"
+	ImperialPint       Unit = "imp pt"
+	ImperialQuart      Unit = "imp qt"
+	Barrel             Unit = "bbl"
+
+	// Gallon and Ounce are the former symbols of the imperial gallon and
+	// fluid ounce, still accepted by Float64In and StringIn.
+	//
+	// Deprecated: String, MarshalJSON and Value now write ImperialGallon and
+	// ImperialFluidOunce, since "gal" reads as a US gallon after
+	// SetCustomarySystem(measure.USCustomary). Use those units instead.
+	Gallon Unit = "gal"
+	Ounce  Unit = "fl. Oz"
+
+	millilitersInLiters     = 1000
+	litersInGallons         = 4.54609
+	ouncesInGallons         = 160
+	litersInUSGallons       = 3.785411784
+	usFluidOuncesInUSGallon = 128
+	litersInHectoliters     = 100
+	litersInCubicMeters     = 1000
+	pintsInGallons          = 8
+	quartsInGallons         = 4
+	teaspoonsInUSGallons    = 768
+	tablespoonsInUSGallons  = 256
+	cupsInUSGallons         = 16
+	usPintsInUSGallons      = 8
+	usQuartsInUSGallons     = 4
+	usGallonsInBarrels      = 31
+
+	// minHectoliterLiters and minCubicMeterLiters are the volumes from which
+	// metric volumes print in hectoliters, as brewery batches of 10 hl and
+	// up, and in cubic meters.
+	minHectoliterLiters = 1000
+	minCubicMeterLiters = 10000
 )
 
 var (
-	parsers = measure.ParserMap[Volume]{
-		"ml":     NewFromMilliliter,
-		"l":      NewFromLiter,
-		"gal":    NewFromGallon,
-		"fl. oz": NewFromOunce,
-		"fl oz":  NewFromOunce,
+	// DefaultUnit is the unit of bare JSON numbers and of JSON objects
+	// without unit.
+	DefaultUnit = Liter
+
+	// customaryPrefixes qualify ambiguous units such as "gal" for a system.
+	customaryPrefixes = map[measure.System][]string{
+		measure.Imperial:    {"imp", "imperial"},
+		measure.USCustomary: {"us"},
+	}
+
+	// units are the built-in units, as listed by Set.
+	units = []Unit{
+		Milliliter, Liter, Hectoliter, CubicMeter,
+		Teaspoon, Tablespoon, Cup, USFluidOunce, USPint, USQuart, USGallon, Barrel,
+		ImperialFluidOunce, ImperialPint, ImperialQuart, ImperialGallon,
+	}
+
+	mixedUnits = map[measure.System][]measure.Unit{
+		measure.Metric: {
+			{Name: string(CubicMeter), Factor: litersInCubicMeters},
+			{Name: string(Liter), Factor: 1},
+			{Name: string(Milliliter), Factor: 1.0 / millilitersInLiters},
+		},
+		measure.Imperial: {
+			{Name: string(ImperialGallon), Factor: 1},
+			{Name: string(ImperialQuart), Factor: 1.0 / quartsInGallons},
+			{Name: string(ImperialPint), Factor: 1.0 / pintsInGallons},
+			{Name: string(ImperialFluidOunce), Factor: 1.0 / ouncesInGallons},
+		},
+		measure.USCustomary: {
+			{Name: string(USGallon), Factor: 1},
+			{Name: string(USQuart), Factor: 1.0 / usQuartsInUSGallons},
+			{Name: string(USPint), Factor: 1.0 / usPintsInUSGallons},
+			{Name: string(Cup), Factor: 1.0 / cupsInUSGallons},
+			{Name: string(Tablespoon), Factor: 1.0 / tablespoonsInUSGallons},
+			{Name: string(Teaspoon), Factor: 1.0 / teaspoonsInUSGallons},
+		},
 	}
+
+	parsers = measure.NewRegistry(measure.ParserMap[Volume]{
+		"ml":               NewFromMilliliter,
+		"milliliter":       NewFromMilliliter,
+		"milliliters":      NewFromMilliliter,
+		"millilitre":       NewFromMilliliter,
+		"millilitres":      NewFromMilliliter,
+		"l":                NewFromLiter,
+		"liter":            NewFromLiter,
+		"liters":           NewFromLiter,
+		"litre":            NewFromLiter,
+		"litres":           NewFromLiter,
+		"hl":               NewFromHectoliter,
+		"hectoliter":       NewFromHectoliter,
+		"hectoliters":      NewFromHectoliter,
+		"hectolitre":       NewFromHectoliter,
+		"hectolitres":      NewFromHectoliter,
+		"m³":               NewFromCubicMeter,
+		"m3":               NewFromCubicMeter,
+		"cubic meter":      NewFromCubicMeter,
+		"cubic meters":     NewFromCubicMeter,
+		"cubic metre":      NewFromCubicMeter,
+		"cubic metres":     NewFromCubicMeter,
+		"gal":              newFromCustomaryGallon,
+		"gallon":           newFromCustomaryGallon,
+		"gallons":          newFromCustomaryGallon,
+		"fl. oz":           newFromCustomaryFluidOunce,
+		"fl oz":            newFromCustomaryFluidOunce,
+		"fluid ounce":      newFromCustomaryFluidOunce,
+		"fluid ounces":     newFromCustomaryFluidOunce,
+		"pt":               newFromCustomaryPint,
+		"pint":             newFromCustomaryPint,
+		"pints":            newFromCustomaryPint,
+		"qt":               newFromCustomaryQuart,
+		"quart":            newFromCustomaryQuart,
+		"quarts":           newFromCustomaryQuart,
+		"imp gal":          NewFromImperialGallon,
+		"uk gal":           NewFromImperialGallon,
+		"imperial gallon":  NewFromImperialGallon,
+		"imperial gallons": NewFromImperialGallon,
+		"imp fl oz":        NewFromImperialFluidOunce,
+		"imp fl. oz":       NewFromImperialFluidOunce,
+		"uk fl oz":         NewFromImperialFluidOunce,
+		"uk fl. oz":        NewFromImperialFluidOunce,
+		"imp pt":           NewFromImperialPint,
+		"uk pt":            NewFromImperialPint,
+		"imperial pint":    NewFromImperialPint,
+		"imperial pints":   NewFromImperialPint,
+		"imp qt":           NewFromImperialQuart,
+		"uk qt":            NewFromImperialQuart,
+		"imperial quart":   NewFromImperialQuart,
+		"imperial quarts":  NewFromImperialQuart,
+		"us gal":           NewFromUSGallon,
+		"us gallon":        NewFromUSGallon,
+		"us gallons":       NewFromUSGallon,
+		"us fl oz":         NewFromUSFluidOunce,
+		"us fl. oz":        NewFromUSFluidOunce,
+		"us pt":            NewFromUSPint,
+		"us pint":          NewFromUSPint,
+		"us pints":         NewFromUSPint,
+		"us qt":            NewFromUSQuart,
+		"us quart":         NewFromUSQuart,
+		"us quarts":        NewFromUSQuart,
+		"tsp":              NewFromTeaspoon,
+		"tsps":             NewFromTeaspoon,
+		"teaspoon":         NewFromTeaspoon,
+		"teaspoons":        NewFromTeaspoon,
+		"tbsp":             NewFromTablespoon,
+		"tbsps":            NewFromTablespoon,
+		"tbs":              NewFromTablespoon,
+		"tablespoon":       NewFromTablespoon,
+		"tablespoons":      NewFromTablespoon,
+		"cup":              NewFromCup,
+		"cups":             NewFromCup,
+		"bbl":              NewFromBarrel,
+		"barrel":           NewFromBarrel,
+		"barrels":          NewFromBarrel,
+	})
+
+	customarySystem      = measure.Imperial
+	customarySystemMutex sync.RWMutex
 )
 
 type (
 	Unit string
 
+	// Volume may hold a negative value, meaning a difference between two
+	// volumes rather than an amount of space. Parsing and every constructor
+	// accept signed values and units are chosen by magnitude.
 	Volume struct {
-		system          measure.System
-		liters, gallons float64
+		system                     measure.System
+		liters, gallons, usGallons float64
+	}
+
+	NullVolume = measure.Null[Volume]
+
+	// dimension registers the package with FindAll and Rewrite, which parse
+	// through Parse so compound quantities such as "2 gal 3 qt" are found whole.
+	dimension struct {
+		*measure.Registry[Volume]
 	}
 )
 
+// SetCustomarySystem chooses whether ambiguous aliases such as "gal",
+// "fl oz", "pt" and "qt" are parsed as imperial or US customary units. It
+// defaults to measure.Imperial.
+func SetCustomarySystem(system measure.System) error {
+	if system != measure.Imperial && system != measure.USCustomary {
+		return fmt.Errorf("%d is an invalid customary system for volume", system)
+	}
+
+	customarySystemMutex.Lock()
+	defer customarySystemMutex.Unlock()
+	customarySystem = system
+	return nil
+}
+
+func CustomarySystem() measure.System {
+	customarySystemMutex.RLock()
+	defer customarySystemMutex.RUnlock()
+	return customarySystem
+}
+
+func init() {
+	parsers.RegisterNames(names)
+	measure.RegisterDimension("volume", dimension{parsers})
+}
+
+func (d dimension) ParseMeasurable(input string) (measure.Measurable, error) {
+	return Parse(input)
+}
+
 func NewFromString(input string) Volume {
-	return parsers.Parse(input)
+	parsed, _ := Parse(input)
+	return parsed
+}
+
+// Parse parses inputs such as "1.5 l", and compound ones such as
+// "2 gal 3 qt", summed in the system of their first term.
+func Parse(input string) (Volume, error) {
+	return measure.ParseCompound(parsers.ParseE, input)
+}
+
+// ParseLenient works like Parse, but also accepts spelled out inputs such as
+// "two cups" or "half a liter". Approximate reports whether vague amounts
+// like "a pinch of salt" were read.
+func ParseLenient(input string) (parsed Volume, approximate bool, err error) {
+	if parsed, err := Parse(input); err == nil {
+		return parsed, false, nil
+	}
+
+	return parsers.ParseLenient(input)
+}
+
+// ParseLocalized parses input written in locale, such as "1,5 l" in German.
+func ParseLocalized(input string, locale measure.Locale) (Volume, error) {
+	return measure.ParseLocalized(Parse, input, locale)
+}
+
+// Var defines a volume flag with the specified name, default value and
+// usage string on flag.CommandLine, storing its value in p.
+func Var(p *Volume, name string, value Volume, usage string) {
+	*p = value
+	flag.Var(p, name, usage)
+}
+
+// RegisterUnit teaches the package a new unit worth factor liters, such as
+// RegisterUnit("bucket", 10, measure.Metric). The unit is accepted by
+// parsing under its name and aliases, by Float64In and StringIn, and is
+// picked by String when it fits the value better than the built-in units.
+func RegisterUnit(name string, factor float64, system measure.System, aliases ...string) error {
+	return parsers.RegisterUnit(name, factor, system, aliases...)
+}
+
+// RegisterAlias makes alias parse the same way as unit.
+func RegisterAlias(alias string, unit Unit) error {
+	return parsers.RegisterAlias(alias, string(unit))
 }
 
 func NewFromMilliliter(value float64) Volume {
@@ -48,16 +287,95 @@ func NewFromLiter(value float64) Volume {
 	return createFromMetric(value)
 }
 
-func NewFromGallon(value float64) Volume {
+func NewFromImperialGallon(value float64) Volume {
 	return createFromImperial(value)
 }
 
-func NewFromOunce(value float64) Volume {
+func NewFromImperialFluidOunce(value float64) Volume {
 	return createFromImperial(value / ouncesInGallons)
 }
 
+func NewFromUSGallon(value float64) Volume {
+	return createFromUSCustomary(value)
+}
+
+func NewFromUSFluidOunce(value float64) Volume {
+	return createFromUSCustomary(value / usFluidOuncesInUSGallon)
+}
+
+func NewFromHectoliter(value float64) Volume {
+	return createFromMetric(value * litersInHectoliters)
+}
+
+func NewFromCubicMeter(value float64) Volume {
+	return createFromMetric(value * litersInCubicMeters)
+}
+
+func NewFromImperialPint(value float64) Volume {
+	return createFromImperial(value / pintsInGallons)
+}
+
+func NewFromImperialQuart(value float64) Volume {
+	return createFromImperial(value / quartsInGallons)
+}
+
+func NewFromTeaspoon(value float64) Volume {
+	return createFromUSCustomary(value / teaspoonsInUSGallons)
+}
+
+func NewFromTablespoon(value float64) Volume {
+	return createFromUSCustomary(value / tablespoonsInUSGallons)
+}
+
+func NewFromCup(value float64) Volume {
+	return createFromUSCustomary(value / cupsInUSGallons)
+}
+
+func NewFromUSPint(value float64) Volume {
+	return createFromUSCustomary(value / usPintsInUSGallons)
+}
+
+func NewFromUSQuart(value float64) Volume {
+	return createFromUSCustomary(value / usQuartsInUSGallons)
+}
+
+func NewFromBarrel(value float64) Volume {
+	return createFromUSCustomary(value * usGallonsInBarrels)
+}
+
+// Deprecated: use NewFromImperialGallon or NewFromUSGallon instead.
+func NewFromGallon(value float64) Volume {
+	return NewFromImperialGallon(value)
+}
+
+// Deprecated: use NewFromImperialFluidOunce or NewFromUSFluidOunce instead.
+func NewFromOunce(value float64) Volume {
+	return NewFromImperialFluidOunce(value)
+}
+
+func (v Volume) System() measure.System {
+	return v.system
+}
+
+// Base returns v in liters.
+func (v Volume) Base() float64 {
+	return v.liters
+}
+
+// FromBase returns a volume of value liters tracked in system.
+func (v Volume) FromBase(value float64, system measure.System) Volume {
+	switch system {
+	case measure.Metric:
+		return createFromMetric(value)
+	case measure.USCustomary:
+		return createFromUSCustomary(value / litersInUSGallons)
+	default:
+		return createFromImperial(value / litersInGallons)
+	}
+}
+
 func (v Volume) IsZero() bool {
-	return v.liters == 0 && v.gallons == 0
+	return v.liters == 0 && v.gallons == 0 && v.usGallons == 0
 }
 
 func (v Volume) Milliliters() float64 {
@@ -68,14 +386,130 @@ func (v Volume) Liters() float64 {
 	return v.liters
 }
 
-func (v Volume) Gallons() float64 {
+func (v Volume) ImperialGallons() float64 {
 	return v.gallons
 }
 
-func (v Volume) Ounces() float64 {
+func (v Volume) ImperialFluidOunces() float64 {
 	return v.gallons * ouncesInGallons
 }
 
+func (v Volume) USGallons() float64 {
+	return v.usGallons
+}
+
+func (v Volume) USFluidOunces() float64 {
+	return v.usGallons * usFluidOuncesInUSGallon
+}
+
+func (v Volume) Hectoliters() float64 {
+	return v.liters / litersInHectoliters
+}
+
+func (v Volume) CubicMeters() float64 {
+	return v.liters / litersInCubicMeters
+}
+
+func (v Volume) ImperialPints() float64 {
+	return v.gallons * pintsInGallons
+}
+
+func (v Volume) ImperialQuarts() float64 {
+	return v.gallons * quartsInGallons
+}
+
+func (v Volume) Teaspoons() float64 {
+	return v.usGallons * teaspoonsInUSGallons
+}
+
+func (v Volume) Tablespoons() float64 {
+	return v.usGallons * tablespoonsInUSGallons
+}
+
+func (v Volume) Cups() float64 {
+	return v.usGallons * cupsInUSGallons
+}
+
+func (v Volume) USPints() float64 {
+	return v.usGallons * usPintsInUSGallons
+}
+
+func (v Volume) USQuarts() float64 {
+	return v.usGallons * usQuartsInUSGallons
+}
+
+func (v Volume) Barrels() float64 {
+	return v.usGallons / usGallonsInBarrels
+}
+
+// Deprecated: use ImperialGallons or USGallons instead.
+func (v Volume) Gallons() float64 {
+	return v.ImperialGallons()
+}
+
+// Deprecated: use ImperialFluidOunces or USFluidOunces instead.
+func (v Volume) Ounces() float64 {
+	return v.ImperialFluidOunces()
+}
+
+// Add returns the sum of v and other. The result keeps the system v was
+// created in, so adding a metric volume to a US customary one yields a US
+// customary volume. Sub, Mul, Div, Neg and Abs follow the same rule.
+func (v Volume) Add(other Volume) Volume {
+	return v.withValue(v.valueIn(v.system) + other.valueIn(v.system))
+}
+
+func (v Volume) Sub(other Volume) Volume {
+	return v.withValue(v.valueIn(v.system) - other.valueIn(v.system))
+}
+
+func (v Volume) Mul(factor float64) Volume {
+	return v.withValue(v.valueIn(v.system) * factor)
+}
+
+func (v Volume) Div(divisor float64) Volume {
+	return v.withValue(v.valueIn(v.system) / divisor)
+}
+
+// Ratio returns how many times other fits in v, regardless of the systems
+// they were created in.
+func (v Volume) Ratio(other Volume) float64 {
+	return v.valueIn(v.system) / other.valueIn(v.system)
+}
+
+func (v Volume) Neg() Volume {
+	return v.withValue(-v.valueIn(v.system))
+}
+
+func (v Volume) Abs() Volume {
+	return v.withValue(math.Abs(v.valueIn(v.system)))
+}
+
+func (v Volume) Compare(other Volume) int {
+	return numeric.Compare(v.liters, other.liters)
+}
+
+func (v Volume) Less(other Volume) bool {
+	return v.Compare(other) < 0
+}
+
+// Equal reports whether v and other represent the same volume, even if they
+// were created in different systems.
+func (v Volume) Equal(other Volume) bool {
+	return v.Compare(other) == 0
+}
+
+// EqualWithin reports whether v and other differ by at most tolerance.
+func (v Volume) EqualWithin(other, tolerance Volume) bool {
+	return math.Abs(v.liters-other.liters) <= math.Abs(tolerance.liters)
+}
+
+// EqualWithinPercent reports whether v and other differ by at most percent
+// of the larger of them.
+func (v Volume) EqualWithinPercent(other Volume, percent float64) bool {
+	return numeric.WithinPercent(v.liters, other.liters, percent)
+}
+
 func (v Volume) String() string {
 	unit := v.findBestUnit()
 	return v.StringIn(unit)
@@ -90,17 +524,92 @@ func (v Volume) StringIn(unit Unit) string {
 	return fmt.Sprintf("%s %s", formatted, unit)
 }
 
+// StringLocalized works like String, but with the separators and unit
+// spacing of locale.
+func (v Volume) StringLocalized(locale measure.Locale) string {
+	unit := v.findBestUnit()
+	value, err := v.Float64In(unit)
+	if err != nil {
+		return ""
+	}
+	return locale.Format(value, names.Abbreviation(string(unit), locale))
+}
+
+// StringLong works like StringLocalized, but with the long name of the unit,
+// such as "2 Liter".
+func (v Volume) StringLong(locale measure.Locale) string {
+	unit := v.findBestUnit()
+	value, err := v.Float64In(unit)
+	if err != nil {
+		return ""
+	}
+	return names.FormatLong(value, "volume", string(unit.named()), locale)
+}
+
+// StringInFraction works like StringIn, but formats the value as a whole
+// number and the closest fraction whose denominator is at most
+// maxDenominator, such as "1 1/2 cup".
+func (v Volume) StringInFraction(unit Unit, maxDenominator int) string {
+	value, err := v.Float64In(unit)
+	if err != nil {
+		return ""
+	}
+	formatted := numeric.FormatFraction(value, maxDenominator)
+	return fmt.Sprintf("%s %s", formatted, unit)
+}
+
+// StringMixed formats v as whole units of its system plus a remainder in
+// smallest, rounded to precision decimal places, such as "2 US gal 3 US qt".
+// It returns empty if smallest is not a unit of the system of v.
+func (v Volume) StringMixed(smallest Unit, precision int) string {
+	units := mixedUnits[v.system]
+	for i, unit := range units {
+		if unit.Name == string(smallest) {
+			return measure.FormatMixed(v.valueIn(v.system), units[:i+1], precision)
+		}
+	}
+
+	return ""
+}
+
 func (v Volume) Float64In(unit Unit) (float64, error) {
 	switch unit {
 	case Milliliter:
 		return v.Milliliters(), nil
 	case Liter:
 		return v.Liters(), nil
-	case Gallon:
-		return v.Gallons(), nil
-	case Ounce:
-		return v.Ounces(), nil
+	case ImperialGallon, Gallon:
+		return v.ImperialGallons(), nil
+	case ImperialFluidOunce, Ounce:
+		return v.ImperialFluidOunces(), nil
+	case USGallon:
+		return v.USGallons(), nil
+	case USFluidOunce:
+		return v.USFluidOunces(), nil
+	case Hectoliter:
+		return v.Hectoliters(), nil
+	case CubicMeter:
+		return v.CubicMeters(), nil
+	case ImperialPint:
+		return v.ImperialPints(), nil
+	case ImperialQuart:
+		return v.ImperialQuarts(), nil
+	case Teaspoon:
+		return v.Teaspoons(), nil
+	case Tablespoon:
+		return v.Tablespoons(), nil
+	case Cup:
+		return v.Cups(), nil
+	case USPint:
+		return v.USPints(), nil
+	case USQuart:
+		return v.USQuarts(), nil
+	case Barrel:
+		return v.Barrels(), nil
 	default:
+		if custom, ok := parsers.Unit(string(unit)); ok {
+			return v.Base() / custom.Factor, nil
+		}
 		return 0, fmt.Errorf("%s is an invalid unit for volume", unit)
 	}
 }
@@ -109,35 +618,222 @@ func (v Volume) MarshalJSON() ([]byte, error) {
 	return measure.Marshal(v)
 }
 
+// MarshalJSONObject marshals v to a JSON object such as
+// {"value":5,"unit":"US gal","system":"us_customary"}. measure.Object uses it.
+func (v Volume) MarshalJSONObject() ([]byte, error) {
+	unit := v.findBestUnit()
+	value, _ := v.Float64In(unit)
+	return measure.MarshalObject(value, string(unit), v.System())
+}
+
 func (v *Volume) UnmarshalJSON(bytes []byte) error {
-	return measure.Unmarshal(v, NewFromString, bytes)
+	return measure.UnmarshalWithDefault(v, Parse, string(DefaultUnit), qualify, bytes)
+}
+
+func (v Volume) MarshalText() ([]byte, error) {
+	return measure.MarshalText(v)
+}
+
+func (v *Volume) UnmarshalText(text []byte) error {
+	return measure.UnmarshalText(v, Parse, text)
+}
+
+func (v Volume) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return measure.MarshalXMLAttr(v, name)
+}
+
+func (v *Volume) UnmarshalXMLAttr(attr xml.Attr) error {
+	return measure.UnmarshalXMLAttr(v, Parse, attr)
+}
+
+// Set implements flag.Value, so *Volume can be given to flag.Var.
+func (v *Volume) Set(value string) error {
+	return measure.Set(v, Parse, symbols(), value)
+}
+
+// Get implements flag.Getter.
+func (v Volume) Get() interface{} {
+	return v
+}
+
+func (v Volume) Value() (driver.Value, error) {
+	return measure.Value(v)
+}
+
+func (v *Volume) Scan(src interface{}) error {
+	return measure.Scan(v, Parse, src)
+}
+
+// qualify returns the unit an ambiguous unit such as "gal" stands for in
+// system, or unit itself.
+func qualify(unit string, system measure.System) string {
+	for _, prefix := range customaryPrefixes[system] {
+		qualified := prefix + " " + unit
+		if _, _, err := parsers.Resolve(qualified); err == nil {
+			return qualified
+		}
+	}
+
+	return unit
+}
+
+// symbols returns the symbols of the built-in units followed by the names
+// of the units registered at runtime.
+func symbols() []string {
+	symbols := make([]string, 0, len(units))
+	for _, unit := range units {
+		symbols = append(symbols, string(unit))
+	}
+
+	return append(symbols, parsers.Names()...)
+}
+
+// named returns the unit whose names describe u, as Gallon and Ounce are
+// catalogued as ImperialGallon and ImperialFluidOunce.
+func (u Unit) named() Unit {
+	switch u {
+	case Gallon:
+		return ImperialGallon
+	case Ounce:
+		return ImperialFluidOunce
+	default:
+		return u
+	}
 }
 
 func (v Volume) findBestUnit() Unit {
-	if v.system == measure.Metric {
+	unit := v.findBuiltInUnit()
+	if value, _ := v.Float64In(unit); value != 0 {
+		if custom, ok := parsers.Best(v.system, v.Base(), math.Abs(v.Base()/value)); ok {
+			return Unit(custom.Name)
+		}
+	}
+
+	return unit
+}
+
+func (v Volume) findBuiltInUnit() Unit {
+	switch v.system {
+	case measure.Metric:
+		liters := math.Abs(v.liters)
 		switch {
-		case v.liters < 1:
+		case liters < 1:
 			return Milliliter
-		default:
+		case liters < minHectoliterLiters:
 			return Liter
+		case liters < minCubicMeterLiters:
+			return Hectoliter
+		default:
+			return CubicMeter
+		}
+	case measure.USCustomary:
+		usGallons := math.Abs(v.usGallons)
+		switch {
+		case usGallons < 1.0/tablespoonsInUSGallons:
+			return Teaspoon
+		case usGallons < 1.0/(cupsInUSGallons*4):
+			return Tablespoon
+		case usGallons < 1.0/usQuartsInUSGallons:
+			return Cup
+		case usGallons < 1:
+			return USQuart
+		case usGallons < usGallonsInBarrels:
+			return USGallon
+		default:
+			return Barrel
+		}
+	default:
+		gallons := math.Abs(v.gallons)
+		switch {
+		case gallons < 1.0/pintsInGallons:
+			return ImperialFluidOunce
+		case gallons < 1:
+			return ImperialPint
+		default:
+			return ImperialGallon
 		}
 	}
+}
 
-	return Gallon
+func (v Volume) valueIn(system measure.System) float64 {
+	switch system {
+	case measure.Metric:
+		return v.liters
+	case measure.USCustomary:
+		return v.usGallons
+	default:
+		return v.gallons
+	}
+}
+
+func (v Volume) withValue(value float64) Volume {
+	switch v.system {
+	case measure.Metric:
+		return createFromMetric(value)
+	case measure.USCustomary:
+		return createFromUSCustomary(value)
+	default:
+		return createFromImperial(value)
+	}
+}
+
+func newFromCustomaryGallon(value float64) Volume {
+	if CustomarySystem() == measure.USCustomary {
+		return NewFromUSGallon(value)
+	}
+
+	return NewFromImperialGallon(value)
+}
+
+func newFromCustomaryFluidOunce(value float64) Volume {
+	if CustomarySystem() == measure.USCustomary {
+		return NewFromUSFluidOunce(value)
+	}
+
+	return NewFromImperialFluidOunce(value)
+}
+
+func newFromCustomaryPint(value float64) Volume {
+	if CustomarySystem() == measure.USCustomary {
+		return NewFromUSPint(value)
+	}
+
+	return NewFromImperialPint(value)
+}
+
+func newFromCustomaryQuart(value float64) Volume {
+	if CustomarySystem() == measure.USCustomary {
+		return NewFromUSQuart(value)
+	}
+
+	return NewFromImperialQuart(value)
 }
 
 func createFromMetric(liters float64) Volume {
 	return Volume{
-		system:  measure.Metric,
-		liters:  liters,
-		gallons: liters / litersInGallons,
+		system:    measure.Metric,
+		liters:    liters,
+		gallons:   liters / litersInGallons,
+		usGallons: liters / litersInUSGallons,
 	}
 }
 
 func createFromImperial(gallons float64) Volume {
+	liters := gallons * litersInGallons
+	return Volume{
+		system:    measure.Imperial,
+		liters:    liters,
+		gallons:   gallons,
+		usGallons: liters / litersInUSGallons,
+	}
+}
+
+func createFromUSCustomary(usGallons float64) Volume {
+	liters := usGallons * litersInUSGallons
 	return Volume{
-		system:  measure.Imperial,
-		liters:  gallons * litersInGallons,
-		gallons: gallons,
+		system:    measure.USCustomary,
+		liters:    liters,
+		gallons:   liters / litersInGallons,
+		usGallons: usGallons,
 	}
 }
@@ -1,8 +1,13 @@
 package volume
 
 import (
+	"encoding/xml"
+	"errors"
+	"flag"
 	"github.com/alancesar/gogram/measure"
+	"github.com/alancesar/gogram/numeric"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -21,9 +26,10 @@ func TestNewFromMilliliter(t *testing.T) {
 				value: 1000.0,
 			},
 			want: Volume{
-				system:  measure.Metric,
-				liters:  1,
-				gallons: 0.21996924829908776,
+				system:    measure.Metric,
+				liters:    1,
+				gallons:   0.21996924829908776,
+				usGallons: 0.26417205235814845,
 			},
 		},
 		{
@@ -32,9 +38,10 @@ func TestNewFromMilliliter(t *testing.T) {
 				value: 4546.09,
 			},
 			want: Volume{
-				system:  measure.Metric,
-				liters:  4.54609,
-				gallons: 1,
+				system:    measure.Metric,
+				liters:    4.54609,
+				gallons:   1,
+				usGallons: 1.200949925504855,
 			},
 		},
 	}
@@ -62,9 +69,10 @@ func TestNewFromLiter(t *testing.T) {
 				value: 10,
 			},
 			want: Volume{
-				system:  measure.Metric,
-				liters:  10,
-				gallons: 2.1996924829908777,
+				system:    measure.Metric,
+				liters:    10,
+				gallons:   2.1996924829908777,
+				usGallons: 2.6417205235814842,
 			},
 		},
 		{
@@ -73,9 +81,10 @@ func TestNewFromLiter(t *testing.T) {
 				value: 4.54609,
 			},
 			want: Volume{
-				system:  measure.Metric,
-				liters:  4.54609,
-				gallons: 1,
+				system:    measure.Metric,
+				liters:    4.54609,
+				gallons:   1,
+				usGallons: 1.200949925504855,
 			},
 		},
 	}
@@ -103,9 +112,10 @@ func TestNewFromGallon(t *testing.T) {
 				value: 1,
 			},
 			want: Volume{
-				system:  measure.Imperial,
-				liters:  4.54609,
-				gallons: 1,
+				system:    measure.Imperial,
+				liters:    4.54609,
+				gallons:   1,
+				usGallons: 1.200949925504855,
 			},
 		},
 		{
@@ -114,9 +124,10 @@ func TestNewFromGallon(t *testing.T) {
 				value: 0.21996924829908777,
 			},
 			want: Volume{
-				system:  measure.Imperial,
-				liters:  1,
-				gallons: 0.21996924829908777,
+				system:    measure.Imperial,
+				liters:    1,
+				gallons:   0.21996924829908777,
+				usGallons: 0.26417205235814845,
 			},
 		},
 	}
@@ -144,9 +155,10 @@ func TestNewFromOunce(t *testing.T) {
 				value: 160,
 			},
 			want: Volume{
-				system:  measure.Imperial,
-				liters:  4.54609,
-				gallons: 1,
+				system:    measure.Imperial,
+				liters:    4.54609,
+				gallons:   1,
+				usGallons: 1.200949925504855,
 			},
 		},
 	}
@@ -159,9 +171,9 @@ func TestNewFromOunce(t *testing.T) {
 	}
 }
 
-func TestNewFromString(t *testing.T) {
+func TestNewFromImperialGallon(t *testing.T) {
 	type args struct {
-		input string
+		value float64
 	}
 	tests := []struct {
 		name string
@@ -169,311 +181,1892 @@ func TestNewFromString(t *testing.T) {
 		want Volume
 	}{
 		{
-			name: "Should parse from '1ml' string",
-			args: args{
-				input: "1ml",
-			},
-			want: NewFromMilliliter(1),
-		},
-		{
-			name: "Should parse from '1l' string",
-			args: args{
-				input: "1l",
-			},
-			want: NewFromLiter(1),
-		},
-		{
-			name: "Should parse from '1gal' string",
+			name: "Should parse from imperial gallons",
 			args: args{
-				input: "1gal",
+				value: 1,
 			},
-			want: NewFromGallon(1),
-		},
-		{
-			name: "Should parse from '1 fl. Oz' string",
-			args: args{
-				input: "1 fl. Oz",
+			want: Volume{
+				system:    measure.Imperial,
+				liters:    4.54609,
+				gallons:   1,
+				usGallons: 1.200949925504855,
 			},
-			want: NewFromOunce(1),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := NewFromString(tt.args.input); !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("NewFromString() = %v, want %v", got, tt.want)
+			if got := NewFromImperialGallon(tt.args.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewFromImperialGallon() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-func TestVolume_IsZero(t *testing.T) {
-	type fields struct {
-		liters float64
+func TestNewFromImperialFluidOunce(t *testing.T) {
+	type args struct {
+		value float64
 	}
 	tests := []struct {
-		name   string
-		fields fields
-		want   bool
+		name string
+		args args
+		want Volume
 	}{
 		{
-			name: "Should return true if is empty",
-			fields: fields{
-				liters: 0,
+			name: "Should parse from imperial fluid ounces",
+			args: args{
+				value: 160,
 			},
-			want: true,
-		},
-		{
-			name: "Should return false if is not empty",
-			fields: fields{
-				liters: 1,
+			want: Volume{
+				system:    measure.Imperial,
+				liters:    4.54609,
+				gallons:   1,
+				usGallons: 1.200949925504855,
 			},
-			want: false,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			v := NewFromLiter(tt.fields.liters)
-			if got := v.IsZero(); got != tt.want {
-				t.Errorf("IsZero() = %v, want %v", got, tt.want)
+			if got := NewFromImperialFluidOunce(tt.args.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewFromImperialFluidOunce() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-func TestVolume_Milliliters(t *testing.T) {
-	type fields struct {
-		system measure.System
-		liters float64
+func TestNewFromUSGallon(t *testing.T) {
+	type args struct {
+		value float64
 	}
 	tests := []struct {
-		name   string
-		fields fields
-		want   float64
+		name string
+		args args
+		want Volume
 	}{
 		{
-			name: "Should return milliliters properly",
-			fields: fields{
-				system: measure.Metric,
-				liters: 0.001,
+			name: "Should parse from US gallons",
+			args: args{
+				value: 1,
+			},
+			want: Volume{
+				system:    measure.USCustomary,
+				liters:    3.785411784,
+				gallons:   0.8326741846289888,
+				usGallons: 1,
 			},
-			want: 1,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			v := Volume{
-				system: tt.fields.system,
-				liters: tt.fields.liters,
-			}
-			if got := v.Milliliters(); got != tt.want {
-				t.Errorf("Milliliters() = %v, want %v", got, tt.want)
+			if got := NewFromUSGallon(tt.args.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewFromUSGallon() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-func TestVolume_Liters(t *testing.T) {
-	type fields struct {
-		system measure.System
-		liters float64
+func TestNewFromUSFluidOunce(t *testing.T) {
+	type args struct {
+		value float64
 	}
 	tests := []struct {
-		name   string
-		fields fields
-		want   float64
+		name string
+		args args
+		want Volume
 	}{
 		{
-			name: "Should return liters properly",
-			fields: fields{
-				system: measure.Metric,
-				liters: 1,
+			name: "Should parse from US fluid ounces",
+			args: args{
+				value: 128,
+			},
+			want: Volume{
+				system:    measure.USCustomary,
+				liters:    3.785411784,
+				gallons:   0.8326741846289888,
+				usGallons: 1,
 			},
-			want: 1,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			v := Volume{
-				system: tt.fields.system,
-				liters: tt.fields.liters,
-			}
-			if got := v.Liters(); got != tt.want {
-				t.Errorf("Liters() = %v, want %v", got, tt.want)
+			if got := NewFromUSFluidOunce(tt.args.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewFromUSFluidOunce() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-func TestVolume_Gallons(t *testing.T) {
-	type fields struct {
-		system  measure.System
-		gallons float64
+func TestNewFromHectoliter(t *testing.T) {
+	type args struct {
+		value float64
 	}
 	tests := []struct {
-		name   string
-		fields fields
-		want   float64
+		name string
+		args args
+		want Volume
 	}{
 		{
-			name: "Should return gallons properly",
-			fields: fields{
-				system:  measure.Imperial,
-				gallons: 1,
+			name: "Should parse from hectoliters",
+			args: args{
+				value: 1,
 			},
-			want: 1,
+			want: NewFromLiter(100),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			v := Volume{
-				system:  tt.fields.system,
-				gallons: tt.fields.gallons,
-			}
-			if got := v.Gallons(); got != tt.want {
-				t.Errorf("Gallons() = %v, want %v", got, tt.want)
+			if got := NewFromHectoliter(tt.args.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewFromHectoliter() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-func TestVolume_Ounces(t *testing.T) {
-	type fields struct {
-		system  measure.System
-		gallons float64
+func TestNewFromCubicMeter(t *testing.T) {
+	type args struct {
+		value float64
 	}
 	tests := []struct {
-		name   string
-		fields fields
-		want   float64
+		name string
+		args args
+		want Volume
 	}{
 		{
-			name: "Should return ounces properly",
-			fields: fields{
-				system:  measure.Imperial,
-				gallons: 1,
+			name: "Should parse from cubic meters",
+			args: args{
+				value: 1,
 			},
-			want: 160,
+			want: NewFromLiter(1000),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			v := Volume{
-				system:  tt.fields.system,
-				gallons: tt.fields.gallons,
-			}
-			if got := v.Ounces(); got != tt.want {
-				t.Errorf("Ounces() = %v, want %v", got, tt.want)
+			if got := NewFromCubicMeter(tt.args.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewFromCubicMeter() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-func TestVolume_String(t *testing.T) {
-	type fields struct {
-		system  measure.System
-		liters  float64
-		gallons float64
+func TestNewFromImperialPint(t *testing.T) {
+	type args struct {
+		value float64
 	}
 	tests := []struct {
-		name   string
-		fields fields
-		want   string
+		name string
+		args args
+		want Volume
 	}{
 		{
-			name: "Should print 1 l",
-			fields: fields{
-				system: measure.Metric,
-				liters: 1,
-			},
-			want: "1 l",
-		},
-		{
-			name: "Should print 1 ml",
-			fields: fields{
-				system: measure.Metric,
-				liters: 0.001,
-			},
-			want: "1 ml",
-		},
-		{
-			name: "Should print 1 gal",
-			fields: fields{
-				system:  measure.Imperial,
-				gallons: 1,
+			name: "Should parse from imperial pints",
+			args: args{
+				value: 8,
 			},
-			want: "1 gal",
+			want: NewFromImperialGallon(1),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			v := Volume{
-				system:  tt.fields.system,
-				liters:  tt.fields.liters,
-				gallons: tt.fields.gallons,
-			}
-			if got := v.String(); got != tt.want {
-				t.Errorf("String() = %v, want %v", got, tt.want)
+			if got := NewFromImperialPint(tt.args.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewFromImperialPint() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-func TestVolume_MarshalJSON(t *testing.T) {
-	type fields struct {
-		system measure.System
-		liters float64
+func TestNewFromImperialQuart(t *testing.T) {
+	type args struct {
+		value float64
 	}
 	tests := []struct {
-		name    string
-		fields  fields
-		want    []byte
-		wantErr bool
+		name string
+		args args
+		want Volume
 	}{
 		{
-			name: "Should marshall properly",
-			fields: fields{
-				system: measure.Metric,
-				liters: 100,
+			name: "Should parse from imperial quarts",
+			args: args{
+				value: 4,
 			},
-			want:    []byte(`"100 l"`),
-			wantErr: false,
+			want: NewFromImperialGallon(1),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			m := Volume{
-				system: tt.fields.system,
-				liters: tt.fields.liters,
-			}
-			got, err := m.MarshalJSON()
-			if (err != nil) != tt.wantErr {
-				t.Errorf("MarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
-				return
-			}
-			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("MarshalJSON() got = %v, want %v", got, tt.want)
+			if got := NewFromImperialQuart(tt.args.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewFromImperialQuart() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-func TestVolume_UnmarshalJSON(t *testing.T) {
+func TestNewFromTeaspoon(t *testing.T) {
 	type args struct {
-		bytes []byte
+		value float64
 	}
 	tests := []struct {
-		name    string
-		args    args
-		want    Volume
-		wantErr bool
+		name string
+		args args
+		want Volume
 	}{
 		{
-			name: "Should unmarshall properly",
+			name: "Should parse from teaspoons",
 			args: args{
-				bytes: []byte(`"100 l"`),
+				value: 768,
 			},
-			want:    NewFromLiter(100),
-			wantErr: false,
+			want: NewFromUSGallon(1),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewFromTeaspoon(tt.args.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewFromTeaspoon() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewFromTablespoon(t *testing.T) {
+	type args struct {
+		value float64
+	}
+	tests := []struct {
+		name string
+		args args
+		want Volume
+	}{
+		{
+			name: "Should parse from tablespoons",
+			args: args{
+				value: 256,
+			},
+			want: NewFromUSGallon(1),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewFromTablespoon(tt.args.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewFromTablespoon() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewFromCup(t *testing.T) {
+	type args struct {
+		value float64
+	}
+	tests := []struct {
+		name string
+		args args
+		want Volume
+	}{
+		{
+			name: "Should parse from cups",
+			args: args{
+				value: 16,
+			},
+			want: NewFromUSGallon(1),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewFromCup(tt.args.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewFromCup() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewFromUSPint(t *testing.T) {
+	type args struct {
+		value float64
+	}
+	tests := []struct {
+		name string
+		args args
+		want Volume
+	}{
+		{
+			name: "Should parse from US pints",
+			args: args{
+				value: 8,
+			},
+			want: NewFromUSGallon(1),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewFromUSPint(tt.args.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewFromUSPint() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewFromUSQuart(t *testing.T) {
+	type args struct {
+		value float64
+	}
+	tests := []struct {
+		name string
+		args args
+		want Volume
+	}{
+		{
+			name: "Should parse from US quarts",
+			args: args{
+				value: 4,
+			},
+			want: NewFromUSGallon(1),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewFromUSQuart(tt.args.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewFromUSQuart() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewFromBarrel(t *testing.T) {
+	type args struct {
+		value float64
+	}
+	tests := []struct {
+		name string
+		args args
+		want Volume
+	}{
+		{
+			name: "Should parse from barrels",
+			args: args{
+				value: 1,
+			},
+			want: NewFromUSGallon(31),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewFromBarrel(tt.args.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewFromBarrel() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewFromString(t *testing.T) {
+	type args struct {
+		input string
+	}
+	tests := []struct {
+		name string
+		args args
+		want Volume
+	}{
+		{
+			name: "Should parse from '1ml' string",
+			args: args{
+				input: "1ml",
+			},
+			want: NewFromMilliliter(1),
+		},
+		{
+			name: "Should parse from '1l' string",
+			args: args{
+				input: "1l",
+			},
+			want: NewFromLiter(1),
+		},
+		{
+			name: "Should parse from '1gal' string",
+			args: args{
+				input: "1gal",
+			},
+			want: NewFromGallon(1),
+		},
+		{
+			name: "Should parse from '1 fl. Oz' string",
+			args: args{
+				input: "1 fl. Oz",
+			},
+			want: NewFromOunce(1),
+		},
+		{
+			name: "Should parse from '-1l' string",
+			args: args{
+				input: "-1l",
+			},
+			want: NewFromLiter(-1),
+		},
+		{
+			name: "Should parse from '1 US gal' string",
+			args: args{
+				input: "1 US gal",
+			},
+			want: NewFromUSGallon(1),
+		},
+		{
+			name: "Should parse from '1 imp gal' string",
+			args: args{
+				input: "1 imp gal",
+			},
+			want: NewFromImperialGallon(1),
+		},
+		{
+			name: "Should parse from '1 US fl. oz' string",
+			args: args{
+				input: "1 US fl. oz",
+			},
+			want: NewFromUSFluidOunce(1),
+		},
+		{
+			name: "Should parse from '1 UK fl  oz' string",
+			args: args{
+				input: "1 UK fl  oz",
+			},
+			want: NewFromImperialFluidOunce(1),
+		},
+		{
+			name: "Should parse from '2 tablespoons' string",
+			args: args{
+				input: "2 tablespoons",
+			},
+			want: NewFromTablespoon(2),
+		},
+		{
+			name: "Should parse from '1 tbsp' string",
+			args: args{
+				input: "1 tbsp",
+			},
+			want: NewFromTablespoon(1),
+		},
+		{
+			name: "Should parse from '3 Teaspoons' string",
+			args: args{
+				input: "3 Teaspoons",
+			},
+			want: NewFromTeaspoon(3),
+		},
+		{
+			name: "Should parse from '1.5 cups' string",
+			args: args{
+				input: "1.5 cups",
+			},
+			want: NewFromCup(1.5),
+		},
+		{
+			name: "Should parse from '2 US pints' string",
+			args: args{
+				input: "2 US pints",
+			},
+			want: NewFromUSPint(2),
+		},
+		{
+			name: "Should parse from '1 imp qt' string",
+			args: args{
+				input: "1 imp qt",
+			},
+			want: NewFromImperialQuart(1),
+		},
+		{
+			name: "Should parse from '7 bbl' string",
+			args: args{
+				input: "7 bbl",
+			},
+			want: NewFromBarrel(7),
+		},
+		{
+			name: "Should parse from '20 hL' string",
+			args: args{
+				input: "20 hL",
+			},
+			want: NewFromHectoliter(20),
+		},
+		{
+			name: "Should parse from '1 m³' string",
+			args: args{
+				input: "1 m³",
+			},
+			want: NewFromCubicMeter(1),
+		},
+		{
+			name: "Should parse from '5 liters' string",
+			args: args{
+				input: "5 liters",
+			},
+			want: NewFromLiter(5),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewFromString(tt.args.input); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewFromString() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	type args struct {
+		input string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Volume
+		wantErr error
+	}{
+		{
+			name: "Should parse from '1l' string",
+			args: args{
+				input: "1l",
+			},
+			want:    NewFromLiter(1),
+			wantErr: nil,
+		},
+		{
+			name: "Should return error for empty input",
+			args: args{
+				input: "",
+			},
+			want:    Volume{},
+			wantErr: measure.ErrEmptyInput,
+		},
+		{
+			name: "Should return error for malformed number",
+			args: args{
+				input: "xl",
+			},
+			want:    Volume{},
+			wantErr: measure.ErrMalformedNumber,
+		},
+		{
+			name: "Should return error for unknown unit",
+			args: args{
+				input: "1 xx",
+			},
+			want:    Volume{},
+			wantErr: measure.ErrUnknownUnit,
+		},
+		{
+			name: "Should parse from compound '2 US gal 3 US qt' string",
+			args: args{
+				input: "2 US gal 3 US qt",
+			},
+			want:    NewFromUSGallon(2.75),
+			wantErr: nil,
+		},
+		{
+			name: "Should parse from compound '2 gal 3 qt' string",
+			args: args{
+				input: "2 gal 3 qt",
+			},
+			want:    NewFromImperialGallon(2.75),
+			wantErr: nil,
+		},
+		{
+			name: "Should parse from compound '1 l 500 ml' string",
+			args: args{
+				input: "1 l 500 ml",
+			},
+			want:    NewFromLiter(1.5),
+			wantErr: nil,
+		},
+		{
+			name: "Should parse from compound '1 m3 200 l' string",
+			args: args{
+				input: "1 m3 200 l",
+			},
+			want:    NewFromLiter(1200),
+			wantErr: nil,
+		},
+		{
+			name: "Should return error for unknown unit in compound '1 l 500' string",
+			args: args{
+				input: "1 l 500",
+			},
+			want:    Volume{},
+			wantErr: measure.ErrUnknownUnit,
+		},
+		{
+			name: "Should parse from fractional '1 1/2 cups' string",
+			args: args{
+				input: "1 1/2 cups",
+			},
+			want:    NewFromCup(1.5),
+			wantErr: nil,
+		},
+		{
+			name: "Should parse from fractional '¾ tsp' string",
+			args: args{
+				input: "¾ tsp",
+			},
+			want:    NewFromTeaspoon(0.75),
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.args.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetCustomarySystem(t *testing.T) {
+	type args struct {
+		system measure.System
+		input  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Volume
+		wantErr bool
+	}{
+		{
+			name: "Should parse 'gal' as US gallon",
+			args: args{
+				system: measure.USCustomary,
+				input:  "5 gal",
+			},
+			want:    NewFromUSGallon(5),
+			wantErr: false,
+		},
+		{
+			name: "Should parse 'fl oz' as US fluid ounce",
+			args: args{
+				system: measure.USCustomary,
+				input:  "12 fl oz",
+			},
+			want:    NewFromUSFluidOunce(12),
+			wantErr: false,
+		},
+		{
+			name: "Should parse 'gal' as imperial gallon",
+			args: args{
+				system: measure.Imperial,
+				input:  "5 gal",
+			},
+			want:    NewFromImperialGallon(5),
+			wantErr: false,
+		},
+		{
+			name: "Should return error for metric system",
+			args: args{
+				system: measure.Metric,
+				input:  "5 gal",
+			},
+			want:    NewFromImperialGallon(5),
+			wantErr: true,
+		},
+		{
+			name: "Should parse 'qt' as US quart",
+			args: args{
+				system: measure.USCustomary,
+				input:  "2 qt",
+			},
+			want:    NewFromUSQuart(2),
+			wantErr: false,
+		},
+		{
+			name: "Should parse 'pints' as imperial pint",
+			args: args{
+				system: measure.Imperial,
+				input:  "2 pints",
+			},
+			want:    NewFromImperialPint(2),
+			wantErr: false,
+		},
+		{
+			name: "Should read printed imperial gallons back as imperial",
+			args: args{
+				system: measure.USCustomary,
+				input:  NewFromImperialGallon(5).String(),
+			},
+			want:    NewFromImperialGallon(5),
+			wantErr: false,
+		},
+		{
+			name: "Should read printed imperial fluid ounces back as imperial",
+			args: args{
+				system: measure.USCustomary,
+				input:  NewFromImperialFluidOunce(10).String(),
+			},
+			want:    NewFromImperialFluidOunce(10),
+			wantErr: false,
+		},
+	}
+	defer func() {
+		_ = SetCustomarySystem(measure.Imperial)
+	}()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := SetCustomarySystem(tt.args.system); (err != nil) != tt.wantErr {
+				t.Errorf("SetCustomarySystem() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := NewFromString(tt.args.input); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewFromString() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVolume_System(t *testing.T) {
+	tests := []struct {
+		name   string
+		volume Volume
+		want   measure.System
+	}{
+		{
+			name:   "Should return metric",
+			volume: NewFromLiter(1),
+			want:   measure.Metric,
+		},
+		{
+			name:   "Should return imperial",
+			volume: NewFromImperialGallon(1),
+			want:   measure.Imperial,
+		},
+		{
+			name:   "Should return US customary",
+			volume: NewFromCup(1),
+			want:   measure.USCustomary,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.volume.System(); got != tt.want {
+				t.Errorf("System() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVolume_Base(t *testing.T) {
+	tests := []struct {
+		name   string
+		volume Volume
+		want   float64
+	}{
+		{
+			name:   "Should return liters",
+			volume: NewFromMilliliter(500),
+			want:   0.5,
+		},
+		{
+			name:   "Should return liters from US gallons",
+			volume: NewFromUSGallon(1),
+			want:   3.785411784,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.volume.Base(); got != tt.want {
+				t.Errorf("Base() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVolume_FromBase(t *testing.T) {
+	type args struct {
+		value  float64
+		system measure.System
+	}
+	tests := []struct {
+		name string
+		args args
+		want Volume
+	}{
+		{
+			name: "Should create metric volume",
+			args: args{
+				value:  2,
+				system: measure.Metric,
+			},
+			want: NewFromLiter(2),
+		},
+		{
+			name: "Should create imperial volume",
+			args: args{
+				value:  4.54609,
+				system: measure.Imperial,
+			},
+			want: NewFromImperialGallon(1),
+		},
+		{
+			name: "Should create US customary volume",
+			args: args{
+				value:  3.785411784,
+				system: measure.USCustomary,
+			},
+			want: NewFromUSGallon(1),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := (Volume{}).FromBase(tt.args.value, tt.args.system); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FromBase() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVolume_IsZero(t *testing.T) {
+	type fields struct {
+		liters float64
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   bool
+	}{
+		{
+			name: "Should return true if is empty",
+			fields: fields{
+				liters: 0,
+			},
+			want: true,
+		},
+		{
+			name: "Should return false if is not empty",
+			fields: fields{
+				liters: 1,
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := NewFromLiter(tt.fields.liters)
+			if got := v.IsZero(); got != tt.want {
+				t.Errorf("IsZero() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVolume_Milliliters(t *testing.T) {
+	type fields struct {
+		system measure.System
+		liters float64
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   float64
+	}{
+		{
+			name: "Should return milliliters properly",
+			fields: fields{
+				system: measure.Metric,
+				liters: 0.001,
+			},
+			want: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := Volume{
+				system: tt.fields.system,
+				liters: tt.fields.liters,
+			}
+			if got := v.Milliliters(); got != tt.want {
+				t.Errorf("Milliliters() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVolume_Liters(t *testing.T) {
+	type fields struct {
+		system measure.System
+		liters float64
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   float64
+	}{
+		{
+			name: "Should return liters properly",
+			fields: fields{
+				system: measure.Metric,
+				liters: 1,
+			},
+			want: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := Volume{
+				system: tt.fields.system,
+				liters: tt.fields.liters,
+			}
+			if got := v.Liters(); got != tt.want {
+				t.Errorf("Liters() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVolume_Gallons(t *testing.T) {
+	type fields struct {
+		system  measure.System
+		gallons float64
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   float64
+	}{
+		{
+			name: "Should return gallons properly",
+			fields: fields{
+				system:  measure.Imperial,
+				gallons: 1,
+			},
+			want: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := Volume{
+				system:  tt.fields.system,
+				gallons: tt.fields.gallons,
+			}
+			if got := v.Gallons(); got != tt.want {
+				t.Errorf("Gallons() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVolume_Ounces(t *testing.T) {
+	type fields struct {
+		system  measure.System
+		gallons float64
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   float64
+	}{
+		{
+			name: "Should return ounces properly",
+			fields: fields{
+				system:  measure.Imperial,
+				gallons: 1,
+			},
+			want: 160,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := Volume{
+				system:  tt.fields.system,
+				gallons: tt.fields.gallons,
+			}
+			if got := v.Ounces(); got != tt.want {
+				t.Errorf("Ounces() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVolume_ImperialGallons(t *testing.T) {
+	tests := []struct {
+		name   string
+		volume Volume
+		want   float64
+	}{
+		{
+			name:   "Should return imperial gallons properly",
+			volume: NewFromLiter(4.54609),
+			want:   1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.volume.ImperialGallons(); got != tt.want {
+				t.Errorf("ImperialGallons() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVolume_ImperialFluidOunces(t *testing.T) {
+	tests := []struct {
+		name   string
+		volume Volume
+		want   float64
+	}{
+		{
+			name:   "Should return imperial fluid ounces properly",
+			volume: NewFromImperialGallon(1),
+			want:   160,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.volume.ImperialFluidOunces(); got != tt.want {
+				t.Errorf("ImperialFluidOunces() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVolume_USGallons(t *testing.T) {
+	tests := []struct {
+		name   string
+		volume Volume
+		want   float64
+	}{
+		{
+			name:   "Should return US gallons properly",
+			volume: NewFromLiter(3.785411784),
+			want:   1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.volume.USGallons(); got != tt.want {
+				t.Errorf("USGallons() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVolume_USFluidOunces(t *testing.T) {
+	tests := []struct {
+		name   string
+		volume Volume
+		want   float64
+	}{
+		{
+			name:   "Should return US fluid ounces properly",
+			volume: NewFromUSGallon(1),
+			want:   128,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.volume.USFluidOunces(); got != tt.want {
+				t.Errorf("USFluidOunces() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVolume_Hectoliters(t *testing.T) {
+	tests := []struct {
+		name   string
+		volume Volume
+		want   float64
+	}{
+		{
+			name:   "Should return hectoliters properly",
+			volume: NewFromLiter(250),
+			want:   2.5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.volume.Hectoliters(); got != tt.want {
+				t.Errorf("Hectoliters() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVolume_CubicMeters(t *testing.T) {
+	tests := []struct {
+		name   string
+		volume Volume
+		want   float64
+	}{
+		{
+			name:   "Should return cubic meters properly",
+			volume: NewFromLiter(2500),
+			want:   2.5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.volume.CubicMeters(); got != tt.want {
+				t.Errorf("CubicMeters() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVolume_ImperialPints(t *testing.T) {
+	tests := []struct {
+		name   string
+		volume Volume
+		want   float64
+	}{
+		{
+			name:   "Should return imperial pints properly",
+			volume: NewFromImperialGallon(1),
+			want:   8,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.volume.ImperialPints(); got != tt.want {
+				t.Errorf("ImperialPints() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVolume_ImperialQuarts(t *testing.T) {
+	tests := []struct {
+		name   string
+		volume Volume
+		want   float64
+	}{
+		{
+			name:   "Should return imperial quarts properly",
+			volume: NewFromImperialGallon(1),
+			want:   4,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.volume.ImperialQuarts(); got != tt.want {
+				t.Errorf("ImperialQuarts() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVolume_Teaspoons(t *testing.T) {
+	tests := []struct {
+		name   string
+		volume Volume
+		want   float64
+	}{
+		{
+			name:   "Should return teaspoons properly",
+			volume: NewFromUSGallon(1),
+			want:   768,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.volume.Teaspoons(); got != tt.want {
+				t.Errorf("Teaspoons() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVolume_Tablespoons(t *testing.T) {
+	tests := []struct {
+		name   string
+		volume Volume
+		want   float64
+	}{
+		{
+			name:   "Should return tablespoons properly",
+			volume: NewFromUSGallon(1),
+			want:   256,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.volume.Tablespoons(); got != tt.want {
+				t.Errorf("Tablespoons() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVolume_Cups(t *testing.T) {
+	tests := []struct {
+		name   string
+		volume Volume
+		want   float64
+	}{
+		{
+			name:   "Should return cups properly",
+			volume: NewFromUSGallon(1),
+			want:   16,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.volume.Cups(); got != tt.want {
+				t.Errorf("Cups() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVolume_USPints(t *testing.T) {
+	tests := []struct {
+		name   string
+		volume Volume
+		want   float64
+	}{
+		{
+			name:   "Should return US pints properly",
+			volume: NewFromUSGallon(1),
+			want:   8,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.volume.USPints(); got != tt.want {
+				t.Errorf("USPints() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVolume_USQuarts(t *testing.T) {
+	tests := []struct {
+		name   string
+		volume Volume
+		want   float64
+	}{
+		{
+			name:   "Should return US quarts properly",
+			volume: NewFromUSGallon(1),
+			want:   4,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.volume.USQuarts(); got != tt.want {
+				t.Errorf("USQuarts() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVolume_Barrels(t *testing.T) {
+	tests := []struct {
+		name   string
+		volume Volume
+		want   float64
+	}{
+		{
+			name:   "Should return barrels properly",
+			volume: NewFromUSGallon(62),
+			want:   2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.volume.Barrels(); got != tt.want {
+				t.Errorf("Barrels() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVolume_Add(t *testing.T) {
+	type args struct {
+		other Volume
+	}
+	tests := []struct {
+		name   string
+		volume Volume
+		args   args
+		want   Volume
+	}{
+		{
+			name:   "Should add liters",
+			volume: NewFromLiter(1),
+			args: args{
+				other: NewFromMilliliter(500),
+			},
+			want: NewFromLiter(1.5),
+		},
+		{
+			name:   "Should keep the US customary system",
+			volume: NewFromUSGallon(1),
+			args: args{
+				other: NewFromLiter(3.785411784),
+			},
+			want: NewFromUSGallon(2),
+		},
+		{
+			name:   "Should keep the imperial system",
+			volume: NewFromImperialGallon(1),
+			args: args{
+				other: NewFromImperialPint(4),
+			},
+			want: NewFromImperialGallon(1.5),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.volume.Add(tt.args.other); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Add() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVolume_Sub(t *testing.T) {
+	type args struct {
+		other Volume
+	}
+	tests := []struct {
+		name   string
+		volume Volume
+		args   args
+		want   Volume
+	}{
+		{
+			name:   "Should subtract cups",
+			volume: NewFromUSGallon(1),
+			args: args{
+				other: NewFromCup(8),
+			},
+			want: NewFromUSGallon(0.5),
+		},
+		{
+			name:   "Should return a negative volume",
+			volume: NewFromLiter(1),
+			args: args{
+				other: NewFromLiter(3),
+			},
+			want: NewFromLiter(-2),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.volume.Sub(tt.args.other); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Sub() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVolume_Mul(t *testing.T) {
+	type args struct {
+		factor float64
+	}
+	tests := []struct {
+		name   string
+		volume Volume
+		args   args
+		want   Volume
+	}{
+		{
+			name:   "Should scale liters",
+			volume: NewFromLiter(20),
+			args: args{
+				factor: 1.5,
+			},
+			want: NewFromLiter(30),
+		},
+		{
+			name:   "Should scale US gallons",
+			volume: NewFromUSGallon(5),
+			args: args{
+				factor: 2,
+			},
+			want: NewFromUSGallon(10),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.volume.Mul(tt.args.factor); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Mul() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVolume_Div(t *testing.T) {
+	type args struct {
+		divisor float64
+	}
+	tests := []struct {
+		name   string
+		volume Volume
+		args   args
+		want   Volume
+	}{
+		{
+			name:   "Should divide liters",
+			volume: NewFromLiter(20),
+			args: args{
+				divisor: 4,
+			},
+			want: NewFromLiter(5),
+		},
+		{
+			name:   "Should divide imperial gallons",
+			volume: NewFromImperialGallon(5),
+			args: args{
+				divisor: 2,
+			},
+			want: NewFromImperialGallon(2.5),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.volume.Div(tt.args.divisor); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Div() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVolume_Ratio(t *testing.T) {
+	type args struct {
+		other Volume
+	}
+	tests := []struct {
+		name   string
+		volume Volume
+		args   args
+		want   float64
+	}{
+		{
+			name:   "Should get the ratio between liters",
+			volume: NewFromLiter(20),
+			args: args{
+				other: NewFromLiter(5),
+			},
+			want: 4,
+		},
+		{
+			name:   "Should get the ratio between systems",
+			volume: NewFromUSGallon(2),
+			args: args{
+				other: NewFromLiter(3.785411784),
+			},
+			want: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.volume.Ratio(tt.args.other); got != tt.want {
+				t.Errorf("Ratio() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVolume_Neg(t *testing.T) {
+	tests := []struct {
+		name   string
+		volume Volume
+		want   Volume
+	}{
+		{
+			name:   "Should negate liters",
+			volume: NewFromLiter(10),
+			want:   NewFromLiter(-10),
+		},
+		{
+			name:   "Should negate US gallons",
+			volume: NewFromUSGallon(-1),
+			want:   NewFromUSGallon(1),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.volume.Neg(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Neg() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVolume_Abs(t *testing.T) {
+	tests := []struct {
+		name   string
+		volume Volume
+		want   Volume
+	}{
+		{
+			name:   "Should return the absolute liters",
+			volume: NewFromLiter(-10),
+			want:   NewFromLiter(10),
+		},
+		{
+			name:   "Should keep positive gallons",
+			volume: NewFromImperialGallon(1),
+			want:   NewFromImperialGallon(1),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.volume.Abs(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Abs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVolume_Compare(t *testing.T) {
+	type args struct {
+		other Volume
+	}
+	tests := []struct {
+		name   string
+		volume Volume
+		args   args
+		want   int
+	}{
+		{
+			name:   "Should return 0 for one US gallon and its liters",
+			volume: NewFromUSGallon(1),
+			args: args{
+				other: NewFromLiter(3.785411784),
+			},
+			want: 0,
+		},
+		{
+			name:   "Should return -1 if less",
+			volume: NewFromUSGallon(1),
+			args: args{
+				other: NewFromImperialGallon(1),
+			},
+			want: -1,
+		},
+		{
+			name:   "Should return 1 if greater",
+			volume: NewFromLiter(1),
+			args: args{
+				other: NewFromCup(4),
+			},
+			want: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.volume.Compare(tt.args.other); got != tt.want {
+				t.Errorf("Compare() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVolume_EqualWithin(t *testing.T) {
+	type args struct {
+		other     Volume
+		tolerance Volume
+	}
+	tests := []struct {
+		name   string
+		volume Volume
+		args   args
+		want   bool
+	}{
+		{
+			name:   "Should return true if within tolerance",
+			volume: NewFromUSGallon(5),
+			args: args{
+				other:     NewFromLiter(19),
+				tolerance: NewFromMilliliter(100),
+			},
+			want: true,
+		},
+		{
+			name:   "Should return false if out of tolerance",
+			volume: NewFromUSGallon(5),
+			args: args{
+				other:     NewFromLiter(20),
+				tolerance: NewFromMilliliter(100),
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.volume.EqualWithin(tt.args.other, tt.args.tolerance); got != tt.want {
+				t.Errorf("EqualWithin() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVolume_String(t *testing.T) {
+	type fields struct {
+		system    measure.System
+		liters    float64
+		gallons   float64
+		usGallons float64
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   string
+	}{
+		{
+			name: "Should print 1 l",
+			fields: fields{
+				system: measure.Metric,
+				liters: 1,
+			},
+			want: "1 l",
+		},
+		{
+			name: "Should print 1 ml",
+			fields: fields{
+				system: measure.Metric,
+				liters: 0.001,
+			},
+			want: "1 ml",
+		},
+		{
+			name: "Should print 1 imp gal",
+			fields: fields{
+				system:  measure.Imperial,
+				gallons: 1,
+			},
+			want: "1 imp gal",
+		},
+		{
+			name: "Should print -1 l",
+			fields: fields{
+				system: measure.Metric,
+				liters: -1,
+			},
+			want: "-1 l",
+		},
+		{
+			name: "Should print 5 US gal",
+			fields: fields{
+				system:    measure.USCustomary,
+				usGallons: 5,
+			},
+			want: "5 US gal",
+		},
+		{
+			name: "Should print 250 ml",
+			fields: fields{
+				system: measure.Metric,
+				liters: 0.25,
+			},
+			want: "250 ml",
+		},
+		{
+			name: "Should print 500 l",
+			fields: fields{
+				system: measure.Metric,
+				liters: 500,
+			},
+			want: "500 l",
+		},
+		{
+			name: "Should print 25 hl",
+			fields: fields{
+				system: measure.Metric,
+				liters: 2500,
+			},
+			want: "25 hl",
+		},
+		{
+			name: "Should print 1000 l as 10 hl",
+			fields: fields{
+				system: measure.Metric,
+				liters: 1000,
+			},
+			want: "10 hl",
+		},
+		{
+			name: "Should print 10 m³",
+			fields: fields{
+				system: measure.Metric,
+				liters: 10000,
+			},
+			want: "10 m³",
+		},
+		{
+			name: "Should print 2 tsp",
+			fields: fields{
+				system:    measure.USCustomary,
+				usGallons: 2.0 / 768,
+			},
+			want: "2 tsp",
+		},
+		{
+			name: "Should print 3 tbsp",
+			fields: fields{
+				system:    measure.USCustomary,
+				usGallons: 3.0 / 256,
+			},
+			want: "3 tbsp",
+		},
+		{
+			name: "Should print 1.5 cup",
+			fields: fields{
+				system:    measure.USCustomary,
+				usGallons: 1.5 / 16,
+			},
+			want: "1.5 cup",
+		},
+		{
+			name: "Should print 2 US qt",
+			fields: fields{
+				system:    measure.USCustomary,
+				usGallons: 0.5,
+			},
+			want: "2 US qt",
+		},
+		{
+			name: "Should print 2 bbl",
+			fields: fields{
+				system:    measure.USCustomary,
+				usGallons: 62,
+			},
+			want: "2 bbl",
+		},
+		{
+			name: "Should print 10 imp fl oz",
+			fields: fields{
+				system:  measure.Imperial,
+				gallons: 10.0 / 160,
+			},
+			want: "10 imp fl oz",
+		},
+		{
+			name: "Should print 4 imp pt",
+			fields: fields{
+				system:  measure.Imperial,
+				gallons: 0.5,
+			},
+			want: "4 imp pt",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := Volume{
+				system:    tt.fields.system,
+				liters:    tt.fields.liters,
+				gallons:   tt.fields.gallons,
+				usGallons: tt.fields.usGallons,
+			}
+			if got := v.String(); got != tt.want {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVolume_MarshalJSON(t *testing.T) {
+	type fields struct {
+		system measure.System
+		liters float64
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name: "Should marshall properly",
+			fields: fields{
+				system: measure.Metric,
+				liters: 100,
+			},
+			want:    []byte(`"100 l"`),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := Volume{
+				system: tt.fields.system,
+				liters: tt.fields.liters,
+			}
+			got, err := m.MarshalJSON()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalJSON() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVolume_UnmarshalJSON(t *testing.T) {
+	type args struct {
+		bytes []byte
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Volume
+		wantErr bool
+	}{
+		{
+			name: "Should unmarshall properly",
+			args: args{
+				bytes: []byte(`"100 l"`),
+			},
+			want:    NewFromLiter(100),
+			wantErr: false,
+		},
+		{
+			name: "Should unmarshal the object form",
+			args: args{
+				bytes: []byte(`{"value":2,"unit":"US gal","system":"us_customary"}`),
+			},
+			want:    NewFromUSGallon(2),
+			wantErr: false,
+		},
+		{
+			name: "Should read ambiguous units in the system of the object",
+			args: args{
+				bytes: []byte(`{"value":5,"unit":"gal","system":"us_customary"}`),
+			},
+			want:    NewFromUSGallon(5),
+			wantErr: false,
+		},
+		{
+			name: "Should read ambiguous imperial units in the system of the object",
+			args: args{
+				bytes: []byte(`{"value":5,"unit":"fl oz","system":"imperial"}`),
+			},
+			want:    NewFromImperialFluidOunce(5),
+			wantErr: false,
+		},
+		{
+			name: "Should return error if the system contradicts the unit",
+			args: args{
+				bytes: []byte(`{"value":5,"unit":"l","system":"imperial"}`),
+			},
+			want:    Volume{},
+			wantErr: true,
+		},
+		{
+			name: "Should unmarshal bare numbers in the default unit",
+			args: args{
+				bytes: []byte("0.5"),
+			},
+			want:    NewFromLiter(0.5),
+			wantErr: false,
+		},
+		{
+			name: "Should return error for unknown unit",
+			args: args{
+				bytes: []byte(`"1 xx"`),
+			},
+			want:    Volume{},
+			wantErr: true,
 		},
 	}
 	for _, tt := range tests {
@@ -483,168 +2076,908 @@ func TestVolume_UnmarshalJSON(t *testing.T) {
 				t.Errorf("UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
 			}
 
-			if !reflect.DeepEqual(*v, tt.want) {
-				t.Errorf("MarshalJSON() got = %v, want %v", v, tt.want)
+			if !reflect.DeepEqual(*v, tt.want) {
+				t.Errorf("MarshalJSON() got = %v, want %v", v, tt.want)
+			}
+		})
+	}
+}
+
+func TestVolume_MarshalJSONObject(t *testing.T) {
+	tests := []struct {
+		name     string
+		quantity Volume
+		want     []byte
+		wantErr  bool
+	}{
+		{
+			name:     "Should marshal NewFromLiter(1.5) as object",
+			quantity: NewFromLiter(1.5),
+			want:     []byte(`{"value":1.5,"unit":"l","system":"metric"}`),
+			wantErr:  false,
+		},
+		{
+			name:     "Should marshal NewFromUSGallon(2) as object",
+			quantity: NewFromUSGallon(2),
+			want:     []byte(`{"value":2,"unit":"US gal","system":"us_customary"}`),
+			wantErr:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.quantity.MarshalJSONObject()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarshalJSONObject() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalJSONObject() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVolume_StringIn(t *testing.T) {
+	type fields struct {
+		liters float64
+	}
+	type args struct {
+		unit Unit
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		want   string
+	}{
+		{
+			name: "Should print 1 l",
+			fields: fields{
+				1,
+			},
+			args: args{
+				unit: Liter,
+			},
+			want: "1 l",
+		},
+		{
+			name: "Should print 1000 ml",
+			fields: fields{
+				1,
+			},
+			args: args{
+				unit: Milliliter,
+			},
+			want: "1000 ml",
+		},
+		{
+			name: "Should print 1 gal",
+			fields: fields{
+				4.54609,
+			},
+			args: args{
+				unit: Gallon,
+			},
+			want: "1 gal",
+		},
+		{
+			name: "Should print 160 fl. Oz",
+			fields: fields{
+				4.54609,
+			},
+			args: args{
+				unit: Ounce,
+			},
+			want: "160 fl. Oz",
+		},
+		{
+			name: "Should print 1 imp gal",
+			fields: fields{
+				4.54609,
+			},
+			args: args{
+				unit: ImperialGallon,
+			},
+			want: "1 imp gal",
+		},
+		{
+			name: "Should return empty string if unit is invalid",
+			fields: fields{
+				liters: 1,
+			},
+			args: args{
+				unit: "Invalid",
+			},
+			want: "",
+		},
+		{
+			name: "Should print 1 US gal",
+			fields: fields{
+				3.785411784,
+			},
+			args: args{
+				unit: USGallon,
+			},
+			want: "1 US gal",
+		},
+		{
+			name: "Should print 128 US fl oz",
+			fields: fields{
+				3.785411784,
+			},
+			args: args{
+				unit: USFluidOunce,
+			},
+			want: "128 US fl oz",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := NewFromLiter(tt.fields.liters)
+			if got := v.StringIn(tt.args.unit); got != tt.want {
+				t.Errorf("StringIn() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVolume_Float64In(t *testing.T) {
+	type fields struct {
+		liters float64
+	}
+	type args struct {
+		unit Unit
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    float64
+		wantErr bool
+	}{
+		{
+			name: "Should get 1 l",
+			fields: fields{
+				liters: 1,
+			},
+			args: args{
+				unit: Liter,
+			},
+			want:    1,
+			wantErr: false,
+		},
+		{
+			name: "Should get 1000 ml",
+			fields: fields{
+				liters: 1,
+			},
+			args: args{
+				unit: Milliliter,
+			},
+			want:    1000,
+			wantErr: false,
+		},
+		{
+			name: "Should get 1 gal",
+			fields: fields{
+				liters: 4.54609,
+			},
+			args: args{
+				unit: Gallon,
+			},
+			want:    1,
+			wantErr: false,
+		},
+		{
+			name: "Should get 160 fl. Oz",
+			fields: fields{
+				liters: 4.54609,
+			},
+			args: args{
+				unit: Ounce,
+			},
+			want:    160,
+			wantErr: false,
+		},
+		{
+			name: "Should return error if unit is invalid",
+			fields: fields{
+				liters: 1,
+			},
+			args: args{
+				unit: "Invalid",
+			},
+			want:    0,
+			wantErr: true,
+		},
+		{
+			name: "Should get 1 US gal",
+			fields: fields{
+				liters: 3.785411784,
+			},
+			args: args{
+				unit: USGallon,
+			},
+			want:    1,
+			wantErr: false,
+		},
+		{
+			name: "Should get 128 US fl oz",
+			fields: fields{
+				liters: 3.785411784,
+			},
+			args: args{
+				unit: USFluidOunce,
+			},
+			want:    128,
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := NewFromLiter(tt.fields.liters)
+			got, err := v.Float64In(tt.args.unit)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Float64In() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Float64In() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVolume_aggregates(t *testing.T) {
+	values := []Volume{NewFromLiter(19), NewFromLiter(21), NewFromUSGallon(5)}
+	tests := []struct {
+		name string
+		got  Volume
+		want Volume
+	}{
+		{
+			name: "Should sum in liters",
+			got:  measure.Sum(values...),
+			want: NewFromLiter(40 + 5*3.785411784),
+		},
+		{
+			name: "Should get the standard deviation in liters",
+			got:  measure.StdDev(NewFromLiter(19), NewFromLiter(21)),
+			want: NewFromLiter(1),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.got.Equal(tt.want) || tt.got.System() != tt.want.System() {
+				t.Errorf("got = %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVolume_Scan(t *testing.T) {
+	want := NewFromLiter(20)
+	value, err := want.Value()
+	if err != nil {
+		t.Fatalf("Value() error = %v", err)
+	}
+	var got Volume
+	if err := got.Scan(value); err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Scan() got = %v, want %v", got, want)
+	}
+}
+
+func TestNullVolume(t *testing.T) {
+	var got NullVolume
+	if err := got.UnmarshalJSON([]byte(`"5 US gal"`)); err != nil {
+		t.Fatalf("UnmarshalJSON() error = %v", err)
+	}
+	if want := measure.NewNull(NewFromUSGallon(5)); !reflect.DeepEqual(got, want) {
+		t.Errorf("UnmarshalJSON() got = %v, want %v", got, want)
+	}
+	if bytes, _ := got.MarshalJSON(); string(bytes) != `"5 US gal"` {
+		t.Errorf("MarshalJSON() got = %s, want %s", bytes, `"5 US gal"`)
+	}
+}
+
+func TestVolume_xml(t *testing.T) {
+	type document struct {
+		XMLName   xml.Name `xml:"document"`
+		Attribute Volume   `xml:"capacity,attr"`
+		Element   Volume   `xml:"capacity"`
+	}
+	tests := []struct {
+		name string
+		doc  document
+		want string
+	}{
+		{
+			name: "Should encode and decode attributes and elements",
+			doc: document{
+				Attribute: NewFromUSGallon(5),
+				Element:   NewFromUSGallon(5),
+			},
+			want: `<document capacity="5 US gal"><capacity>5 US gal</capacity></document>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded, err := xml.Marshal(tt.doc)
+			if err != nil {
+				t.Fatalf("xml.Marshal() error = %v", err)
+			}
+			if string(encoded) != tt.want {
+				t.Errorf("xml.Marshal() got = %s, want %s", encoded, tt.want)
+			}
+
+			var decoded document
+			if err := xml.Unmarshal(encoded, &decoded); err != nil {
+				t.Fatalf("xml.Unmarshal() error = %v", err)
+			}
+			if !reflect.DeepEqual(decoded.Attribute, tt.doc.Attribute) || !reflect.DeepEqual(decoded.Element, tt.doc.Element) {
+				t.Errorf("xml.Unmarshal() got = %v, want %v", decoded, tt.doc)
+			}
+		})
+	}
+}
+
+func TestVar(t *testing.T) {
+	defer func(commandLine *flag.FlagSet) {
+		flag.CommandLine = commandLine
+	}(flag.CommandLine)
+	flag.CommandLine = flag.NewFlagSet("test", flag.ContinueOnError)
+
+	var got Volume
+	Var(&got, "value", NewFromLiter(20), "usage")
+	if err := flag.Set("value", "23l"); err != nil {
+		t.Errorf("flag.Set() error = %v", err)
+	}
+	if want := NewFromLiter(23); !reflect.DeepEqual(flag.Lookup("value").Value.(flag.Getter).Get(), want) {
+		t.Errorf("Var() got = %v, want %v", got, want)
+	}
+
+	wantMessage := "accepted units: ml, l, hl, m³, tsp, tbsp, cup, US fl oz, US pt, US qt, US gal, bbl, imp fl oz, imp pt, imp qt, imp gal"
+	if err := flag.Set("value", "5 xx"); err == nil || !strings.HasSuffix(err.Error(), wantMessage) {
+		t.Errorf("flag.Set() error = %v, want message %v", err, wantMessage)
+	}
+}
+
+func TestRegisterUnit(t *testing.T) {
+	defer func(original *measure.Registry[Volume]) {
+		parsers = original
+	}(parsers)
+	parsers = measure.NewRegistry(measure.ParserMap[Volume]{"l": NewFromLiter, "us gal": NewFromUSGallon})
+
+	if err := RegisterUnit("keg", 58.67, measure.Metric, "kegs"); err != nil {
+		t.Fatalf("RegisterUnit() error = %v", err)
+	}
+
+	got, err := Parse("2 kegs")
+	if err != nil {
+		t.Errorf("Parse() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, NewFromLiter(117.34)) {
+		t.Errorf("Parse() got = %v, want %v", got, NewFromLiter(117.34))
+	}
+	if got.String() != "2 keg" {
+		t.Errorf("String() got = %v, want %v", got.String(), "2 keg")
+	}
+	if got := NewFromLiter(20).String(); got != "20 l" {
+		t.Errorf("String() got = %v, want %v", got, "20 l")
+	}
+	if got, err := NewFromLiter(29.335).Float64In("keg"); err != nil || numeric.Compare(got, 0.5) != 0 {
+		t.Errorf("Float64In() got = %v, %v, want %v", got, err, 0.5)
+	}
+	if err := RegisterUnit("keg", 58.67, measure.Metric, "kegs"); !errors.Is(err, measure.ErrUnitAlreadyRegistered) {
+		t.Errorf("RegisterUnit() error = %v, want %v", err, measure.ErrUnitAlreadyRegistered)
+	}
+}
+
+func TestRegisterAlias(t *testing.T) {
+	defer func(original *measure.Registry[Volume]) {
+		parsers = original
+	}(parsers)
+	parsers = measure.NewRegistry(measure.ParserMap[Volume]{"l": NewFromLiter, "us gal": NewFromUSGallon})
+
+	if err := RegisterAlias("Fl.Gal.", USGallon); err != nil {
+		t.Fatalf("RegisterAlias() error = %v", err)
+	}
+
+	got, err := Parse("2 fl.gal.")
+	if err != nil {
+		t.Errorf("Parse() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, NewFromUSGallon(2)) {
+		t.Errorf("Parse() got = %v, want %v", got, NewFromUSGallon(2))
+	}
+}
+
+func TestLoadDefinitions(t *testing.T) {
+	defer func(original *measure.Registry[Volume]) {
+		parsers = original
+		measure.RegisterDimension("volume", dimension{parsers})
+	}(parsers)
+	parsers = measure.NewRegistry(measure.ParserMap[Volume]{"l": NewFromLiter, "ml": NewFromMilliliter})
+	measure.RegisterDimension("volume", dimension{parsers})
+
+	err := measure.LoadDefinitions(strings.NewReader(`{"volume": {
+		"units": [{"name": "bucket", "value": 18.9, "unit": "l", "aliases": ["buckets"]}],
+		"aliases": {"Fl.L.": "l"}
+	}}`))
+	if err != nil {
+		t.Fatalf("LoadDefinitions() error = %v", err)
+	}
+
+	got, err := Parse("2 buckets")
+	if err != nil {
+		t.Errorf("Parse() error = %v", err)
+	}
+	if numeric.Compare(got.Liters(), 37.8) != 0 || got.String() != "2 bucket" {
+		t.Errorf("Parse() got = %v, want %v", got, "2 bucket")
+	}
+
+	got, err = Parse("2 fl.l.")
+	if err != nil {
+		t.Errorf("Parse() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, NewFromLiter(2)) {
+		t.Errorf("Parse() got = %v, want %v", got, NewFromLiter(2))
+	}
+}
+
+func TestVolume_StringMixed(t *testing.T) {
+	type args struct {
+		smallest  Unit
+		precision int
+	}
+	tests := []struct {
+		name     string
+		quantity Volume
+		args     args
+		want     string
+	}{
+		{
+			name:     "Should format US gallons and quarts",
+			quantity: NewFromUSGallon(2.75),
+			args: args{
+				smallest:  USQuart,
+				precision: 1,
+			},
+			want: "2 US gal 3 US qt",
+		},
+		{
+			name:     "Should format cups and tablespoons",
+			quantity: NewFromTablespoon(18),
+			args: args{
+				smallest:  Teaspoon,
+				precision: 0,
+			},
+			want: "1 cup 2 tbsp",
+		},
+		{
+			name:     "Should format imperial pints",
+			quantity: NewFromImperialPint(11),
+			args: args{
+				smallest:  ImperialFluidOunce,
+				precision: 0,
+			},
+			want: "1 imp gal 1 imp qt 1 imp pt",
+		},
+		{
+			name:     "Should format liters and milliliters",
+			quantity: NewFromMilliliter(1500),
+			args: args{
+				smallest:  Milliliter,
+				precision: 0,
+			},
+			want: "1 l 500 ml",
+		},
+		{
+			name:     "Should return empty for units of other systems",
+			quantity: NewFromLiter(1),
+			args: args{
+				smallest:  Cup,
+				precision: 0,
+			},
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.quantity.StringMixed(tt.args.smallest, tt.args.precision); got != tt.want {
+				t.Errorf("StringMixed() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-func TestVolume_StringIn(t *testing.T) {
-	type fields struct {
-		liters float64
+func TestVolume_StringInFraction(t *testing.T) {
+	type args struct {
+		unit           Unit
+		maxDenominator int
+	}
+	tests := []struct {
+		name     string
+		quantity Volume
+		args     args
+		want     string
+	}{
+		{
+			name:     "Should format cups as fraction",
+			quantity: NewFromCup(1.5),
+			args: args{
+				unit:           Cup,
+				maxDenominator: 4,
+			},
+			want: "1 1/2 cup",
+		},
+		{
+			name:     "Should format teaspoons as fraction",
+			quantity: NewFromTeaspoon(0.5),
+			args: args{
+				unit:           Teaspoon,
+				maxDenominator: 8,
+			},
+			want: "1/2 tsp",
+		},
+		{
+			name:     "Should return empty for invalid units",
+			quantity: NewFromCup(1),
+			args: args{
+				unit:           Unit("xx"),
+				maxDenominator: 8,
+			},
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.quantity.StringInFraction(tt.args.unit, tt.args.maxDenominator); got != tt.want {
+				t.Errorf("StringInFraction() = %v, want %v", got, tt.want)
+			}
+		})
 	}
+}
+
+func TestParseLenient(t *testing.T) {
 	type args struct {
-		unit Unit
+		input string
 	}
 	tests := []struct {
-		name   string
-		fields fields
-		args   args
-		want   string
+		name            string
+		args            args
+		want            Volume
+		wantApproximate bool
+		wantErr         bool
 	}{
 		{
-			name: "Should print 1 l",
-			fields: fields{
-				1,
+			name: "Should parse compound inputs like Parse",
+			args: args{
+				input: "1 US gal 2 US qt",
 			},
+			want:            NewFromUSGallon(1.5),
+			wantApproximate: false,
+			wantErr:         false,
+		},
+		{
+			name: "Should parse 'two cups'",
 			args: args{
-				unit: Liter,
+				input: "two cups",
 			},
-			want: "1 l",
+			want:            NewFromCup(2),
+			wantApproximate: false,
+			wantErr:         false,
 		},
 		{
-			name: "Should print 1000 ml",
-			fields: fields{
-				1,
+			name: "Should parse 'three liters of water'",
+			args: args{
+				input: "three liters of water",
 			},
+			want:            NewFromLiter(3),
+			wantApproximate: false,
+			wantErr:         false,
+		},
+		{
+			name: "Should parse 'a pinch of salt'",
 			args: args{
-				unit: Milliliter,
+				input: "a pinch of salt",
 			},
-			want: "1000 ml",
+			want:            NewFromTeaspoon(1.0 / 16),
+			wantApproximate: true,
+			wantErr:         false,
 		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, approximate, err := ParseLenient(tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseLenient() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseLenient() got = %v, want %v", got, tt.want)
+			}
+			if approximate != tt.wantApproximate {
+				t.Errorf("ParseLenient() approximate = %v, want %v", approximate, tt.wantApproximate)
+			}
+		})
+	}
+}
+
+func TestFindAll(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []measure.Match
+	}{
 		{
-			name: "Should print 1 gal",
-			fields: fields{
-				4.54609,
+			name: "Should find every volume in the text",
+			text: "Heat 20 l of water, then add 2 US fl oz of lactic acid",
+			want: []measure.Match{
+				{
+					Dimension: "volume",
+					Value:     NewFromLiter(20),
+					Text:      "20 l",
+					Start:     5,
+					End:       9,
+				},
+				{
+					Dimension: "volume",
+					Value:     NewFromUSFluidOunce(2),
+					Text:      "2 US fl oz",
+					Start:     29,
+					End:       39,
+				},
 			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := measure.FindAll(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FindAll() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRewrite(t *testing.T) {
+	type args struct {
+		text    string
+		system  measure.System
+		options measure.RewriteOptions
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "Should convert US customary volumes to metric",
 			args: args{
-				unit: Gallon,
+				text:    "Boil 5 US gal, then add ½ tsp of salt.",
+				system:  measure.Metric,
+				options: measure.RewriteOptions{Precision: 2, KeepOriginal: true},
 			},
-			want: "1 gal",
+			want: "Boil 18.93 l (5 US gal), then add 2.46 ml (½ tsp) of salt.",
 		},
 		{
-			name: "Should print 160 fl. Oz",
-			fields: fields{
-				4.54609,
+			name: "Should convert metric volumes to US customary",
+			args: args{
+				text:    "Heat 20 l of water.",
+				system:  measure.USCustomary,
+				options: measure.RewriteOptions{Precision: 2, KeepOriginal: false},
 			},
+			want: "Heat 5.28 US gal of water.",
+		},
+		{
+			name: "Should keep three significant digits with zero options",
 			args: args{
-				unit: Ounce,
+				text:    "Boil 2 US gal, then add ½ tsp of salt.",
+				system:  measure.Imperial,
+				options: measure.RewriteOptions{},
 			},
-			want: "160 fl. Oz",
+			want: "Boil 1.67 imp gal, then add 0.0867 imp fl oz of salt.",
 		},
 		{
-			name: "Should return empty string if unit is invalid",
-			fields: fields{
-				liters: 1,
+			name: "Should convert numbers with thousands separators",
+			args: args{
+				text:    "Use 2,500 ml water.",
+				system:  measure.USCustomary,
+				options: measure.RewriteOptions{Precision: 2, KeepOriginal: true},
 			},
+			want: "Use 2.64 US qt (2,500 ml) water.",
+		},
+		{
+			name: "Should keep the original of ranges once",
 			args: args{
-				unit: "Invalid",
+				text:    "Add 2-3 l water.",
+				system:  measure.USCustomary,
+				options: measure.RewriteOptions{Precision: 2, KeepOriginal: true},
 			},
-			want: "",
+			want: "Add 2.11 US qt-3.17 US qt (2-3 l) water.",
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			v := NewFromLiter(tt.fields.liters)
-			if got := v.StringIn(tt.args.unit); got != tt.want {
-				t.Errorf("StringIn() = %v, want %v", got, tt.want)
+			if got := measure.Rewrite(tt.args.text, tt.args.system, tt.args.options); got != tt.want {
+				t.Errorf("Rewrite() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-func TestVolume_Float64In(t *testing.T) {
-	type fields struct {
-		liters float64
-	}
+func TestParseLocalized(t *testing.T) {
 	type args struct {
-		unit Unit
+		input  string
+		locale measure.Locale
 	}
 	tests := []struct {
 		name    string
-		fields  fields
 		args    args
-		want    float64
+		want    Volume
 		wantErr bool
 	}{
 		{
-			name: "Should get 1 l",
-			fields: fields{
-				liters: 1,
+			name: "Should parse German decimals",
+			args: args{
+				input:  "1,5 l",
+				locale: measure.German,
 			},
+			want:    NewFromLiter(1.5),
+			wantErr: false,
+		},
+		{
+			name: "Should parse French grouped numbers",
 			args: args{
-				unit: Liter,
+				input:  "1\u202f250 ml",
+				locale: measure.French,
 			},
-			want:    1,
+			want:    NewFromMilliliter(1250),
 			wantErr: false,
 		},
 		{
-			name: "Should get 1000 ml",
-			fields: fields{
-				liters: 1,
+			name: "Should return error for unknown units",
+			args: args{
+				input:  "1,5 foo",
+				locale: measure.German,
+			},
+			want:    Volume{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseLocalized(tt.args.input, tt.args.locale)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseLocalized() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseLocalized() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVolume_StringLocalized(t *testing.T) {
+	type args struct {
+		locale measure.Locale
+	}
+	tests := []struct {
+		name string
+		v    Volume
+		args args
+		want string
+	}{
+		{
+			name: "Should format in English",
+			v:    NewFromLiter(1.5),
+			args: args{
+				locale: measure.English,
 			},
+			want: "1.5 l",
+		},
+		{
+			name: "Should format in German",
+			v:    NewFromMilliliter(250.5),
 			args: args{
-				unit: Milliliter,
+				locale: measure.German,
 			},
-			want:    1000,
-			wantErr: false,
+			want: "250,5\u00a0ml",
 		},
 		{
-			name: "Should get 1 gal",
-			fields: fields{
-				liters: 4.54609,
+			name: "Should use localized abbreviations",
+			v:    NewFromTablespoon(2),
+			args: args{
+				locale: measure.German,
 			},
+			want: "2\u00a0EL",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.v.StringLocalized(tt.args.locale); got != tt.want {
+				t.Errorf("StringLocalized() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVolume_StringLong(t *testing.T) {
+	type args struct {
+		locale measure.Locale
+	}
+	tests := []struct {
+		name string
+		v    Volume
+		args args
+		want string
+	}{
+		{
+			name: "Should use the German singular",
+			v:    NewFromLiter(1),
 			args: args{
-				unit: Gallon,
+				locale: measure.German,
 			},
-			want:    1,
-			wantErr: false,
+			want: "1\u00a0Liter",
 		},
 		{
-			name: "Should get 160 fl. Oz",
-			fields: fields{
-				liters: 4.54609,
+			name: "Should use the German plural",
+			v:    NewFromLiter(2),
+			args: args{
+				locale: measure.German,
 			},
+			want: "2\u00a0Liter",
+		},
+		{
+			name: "Should use French names",
+			v:    NewFromTablespoon(3),
 			args: args{
-				unit: Ounce,
+				locale: measure.French,
 			},
-			want:    160,
-			wantErr: false,
+			want: "3\u00a0cuillères à soupe",
 		},
 		{
-			name: "Should return error if unit is invalid",
-			fields: fields{
-				liters: 1,
+			name: "Should use English names",
+			v:    NewFromUSGallon(2),
+			args: args{
+				locale: measure.English,
 			},
+			want: "2 US gallons",
+		},
+		{
+			name: "Should name imperial gallons",
+			v:    NewFromImperialGallon(2),
 			args: args{
-				unit: "Invalid",
+				locale: measure.English,
 			},
-			want:    0,
-			wantErr: true,
+			want: "2 imperial gallons",
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			v := NewFromLiter(tt.fields.liters)
-			got, err := v.Float64In(tt.args.unit)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("Float64In() error = %v, wantErr %v", err, tt.wantErr)
-				return
-			}
-			if got != tt.want {
-				t.Errorf("Float64In() got = %v, want %v", got, tt.want)
+			if got := tt.v.StringLong(tt.args.locale); got != tt.want {
+				t.Errorf("StringLong() = %q, want %q", got, tt.want)
 			}
 		})
 	}
 }
+
+func Test_names(t *testing.T) {
+	for unit, byLocale := range names {
+		want := NewFromString("2 " + unit)
+		for tag, name := range byLocale {
+			for _, alias := range []string{name.Singular, name.Plural, name.Abbreviation} {
+				if alias == "" {
+					continue
+				}
+				if got := NewFromString("2 " + alias); !reflect.DeepEqual(got, want) {
+					t.Errorf("%s name %q of %q parsed as %v, want %v", tag, alias, unit, got, want)
+				}
+			}
+		}
+	}
+}